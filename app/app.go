@@ -136,6 +136,15 @@ type HeimdallApp struct {
 
 	// simulation module manager
 	sm *hmModule.SimulationManager
+
+	// invariants registered by modules
+	invariants InvariantRegistry
+
+	// invariant check period in blocks, 0 disables the check
+	invCheckPeriod uint64
+
+	// halt on broken invariant instead of logging
+	invCheckHalt bool
 }
 
 var logger = helper.Logger.With("module", "app")
@@ -342,7 +351,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	govRouter.
 		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
 		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(featuremanagerTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(featuremanagerTypes.RouterKey, featuremanager.NewFeatureChangeProposalHandler(
+			&app.FeatureKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper)))
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -357,10 +367,16 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	// create feature keeper
 	app.FeatureKeeper = featuremanager.NewKeeper(
 		app.cdc,
+		keys[paramsTypes.StoreKey],
 		app.subspaces[featuremanagerTypes.ModuleName],
 		app.GovKeeper,
+		app.StakingKeeper,
+		app.ChainKeeper,
 		common.DefaultCodespace,
 	)
+	for _, u := range app.upgrades() {
+		app.FeatureKeeper.RegisterUpgrade(u.Name)
+	}
 
 	app.CheckpointKeeper = checkpoint.NewKeeper(
 		app.cdc,
@@ -379,6 +395,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		common.DefaultCodespace,
 		app.ChainKeeper,
 		app.StakingKeeper,
+		app.SlashingKeeper,
 		app.caller,
 	)
 
@@ -397,6 +414,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[topupTypes.ModuleName],
 		topupTypes.DefaultCodespace,
 		app.ChainKeeper,
+		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
 	)
@@ -436,10 +454,12 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
 		topupTypes.ModuleName,
+		featuremanagerTypes.ModuleName,
 	)
 
 	// register message routes and query routes
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.mm.RegisterInvariants(&app.invariants)
 
 	// side router
 	app.sideRouter = types.NewSideRouter()
@@ -573,6 +593,7 @@ func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 		ctx,
 		types.BytesToHeimdallAddress(req.Header.GetProposerAddress()),
 	)
+	app.applyUpgrades(ctx)
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -640,6 +661,9 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	// end block
 	app.mm.EndBlock(ctx, req)
 
+	// assert invariants
+	app.checkInvariants(ctx)
+
 	// send validator updates to peppermint
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
@@ -12,7 +12,12 @@ import (
 	db "github.com/tendermint/tm-db"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	featuremanagerTypes "github.com/maticnetwork/heimdall/featuremanager/types"
 	"github.com/maticnetwork/heimdall/simulation"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
 )
 
@@ -115,6 +120,75 @@ func TestSetupWithGenesisAccounts(t *testing.T) {
 	require.LessOrEqual(t, 10, len(happ.AccountKeeper.GetAllAccounts(ctx)))
 }
 
+func TestAssertInvariants(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+
+	routes := happ.invariants.Routes()
+	require.Contains(t, routes, "checkpoint/checkpoints")
+	require.Contains(t, routes, "staking/validator-set")
+	require.Contains(t, routes, "topup/dividend-accounts")
+	require.Contains(t, routes, "clerk/event-records")
+
+	require.Empty(t, happ.AssertInvariants(ctx))
+}
+
+func TestApplyStakingQueueMigration(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 9})
+
+	rootChainID := hmTypes.GetRootChainID(hmTypes.RootChainTypeEth)
+	records := []stakingTypes.StakingRecord{
+		{Type: "validatorJoin", ValidatorID: 5, Nonce: 1, TxHash: hmTypes.ZeroHeimdallHash},
+	}
+
+	// queue stored as single list
+	legacyKey := []byte{0x31, rootChainID}
+	store := ctx.KVStore(happ.keys[stakingTypes.StoreKey])
+	store.Set(legacyKey, happ.Codec().MustMarshalBinaryBare(records))
+
+	isOpen, activationHeight := true, uint64(10)
+	require.NoError(t, happ.FeatureKeeper.SetFeatureParams(ctx, featuremanagerTypes.FeatureParams{
+		FeatureParamMap: map[string]featuremanagerTypes.FeatureData{
+			StakingQueueMigration: {IsOpen: &isOpen, ActivationHeight: &activationHeight},
+		},
+	}))
+
+	happ.applyUpgrades(ctx)
+	require.True(t, store.Has(legacyKey))
+
+	happ.applyUpgrades(ctx.WithBlockHeight(10))
+	require.False(t, store.Has(legacyKey))
+
+	queue, err := happ.StakingKeeper.GetStakingQueue(ctx, rootChainID)
+	require.NoError(t, err)
+	require.Equal(t, records, queue)
+}
+
+func TestApplyUpgrades(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 9})
+
+	// chain started before fee supply was tracked
+	ctx.KVStore(happ.keys[topupTypes.StoreKey]).Delete(topup.FeeSupplyKey)
+
+	isOpen, activationHeight := true, uint64(10)
+	require.NoError(t, happ.FeatureKeeper.SetFeatureParams(ctx, featuremanagerTypes.FeatureParams{
+		FeatureParamMap: map[string]featuremanagerTypes.FeatureData{
+			TopupFeeSupply: {IsOpen: &isOpen, ActivationHeight: &activationHeight},
+		},
+	}))
+
+	happ.applyUpgrades(ctx)
+	_, ok := happ.TopupKeeper.GetFeeSupply(ctx)
+	require.False(t, ok)
+
+	happ.applyUpgrades(ctx.WithBlockHeight(10))
+	_, ok = happ.TopupKeeper.GetFeeSupply(ctx)
+	require.True(t, ok)
+	require.Empty(t, happ.AssertInvariants(ctx))
+}
+
 func TestValidateGenesis(t *testing.T) {
 	happ := Setup(false)
 
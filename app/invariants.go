@@ -0,0 +1,79 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// invariantRoute invariant registered by a module
+type invariantRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// InvariantRegistry collects invariants registered by modules
+type InvariantRegistry struct {
+	routes []invariantRoute
+}
+
+var _ sdk.InvariantRegistry = (*InvariantRegistry)(nil)
+
+// RegisterRoute registers invariant of module
+func (ir *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, invariantRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	})
+}
+
+// Routes returns names of registered invariants as module/route
+func (ir *InvariantRegistry) Routes() []string {
+	routes := make([]string, 0, len(ir.routes))
+	for _, r := range ir.routes {
+		routes = append(routes, fmt.Sprintf("%s/%s", r.ModuleName, r.Route))
+	}
+
+	return routes
+}
+
+// SetInvariantCheck sets how often invariants run in end block, 0 disables the check,
+// and whether a broken invariant halts the node instead of being logged
+func (app *HeimdallApp) SetInvariantCheck(period uint64, halt bool) {
+	app.invCheckPeriod = period
+	app.invCheckHalt = halt
+}
+
+// AssertInvariants runs all registered invariants and returns messages of the broken ones
+func (app *HeimdallApp) AssertInvariants(ctx sdk.Context) (broken []string) {
+	for _, r := range app.invariants.routes {
+		if res, stop := r.Invar(ctx); stop {
+			broken = append(broken, res)
+		}
+	}
+
+	return broken
+}
+
+// checkInvariants runs invariants every invariant check period blocks
+func (app *HeimdallApp) checkInvariants(ctx sdk.Context) {
+	if app.invCheckPeriod == 0 || uint64(ctx.BlockHeight())%app.invCheckPeriod != 0 {
+		return
+	}
+
+	broken := app.AssertInvariants(ctx)
+	if len(broken) == 0 {
+		logger.Debug("Asserted all invariants", "height", ctx.BlockHeight(), "count", len(app.invariants.routes))
+		return
+	}
+
+	for _, res := range broken {
+		logger.Error("Invariant broken", "height", ctx.BlockHeight(), "invariant", res)
+	}
+
+	if app.invCheckHalt {
+		panic(fmt.Errorf("%d invariants broken at height %d:\n%s", len(broken), ctx.BlockHeight(), broken[0]))
+	}
+}
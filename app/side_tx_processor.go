@@ -10,6 +10,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -57,10 +58,17 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 		return
 	}
 
-	// calculate power
-	var totalPower int64
-	for _, v := range validators {
-		totalPower = totalPower + v.Power
+	// calculate power, single validator power is capped by max validator power percent
+	rawPowers := make([]int64, len(validators))
+	for i, v := range validators {
+		rawPowers[i] = v.Power
+	}
+	powers := app.StakingKeeper.CapValidatorPowers(ctx, rawPowers)
+
+	var totalPower, totalRawPower int64
+	for i, power := range powers {
+		totalPower = totalPower + power
+		totalRawPower = totalRawPower + rawPowers[i]
 	}
 
 	// get empty events
@@ -82,21 +90,34 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 			signedPower[abci.SideTxResultType_Skip] = 0
 			signedPower[abci.SideTxResultType_No] = 0
 
+			var signedRawYesPower int64
+
 			for _, sigObj := range sideTxResult.Sigs {
 				// get validator by sig address
 				if i := getValidatorIndexByAddress(sigObj.Address, validators); i != -1 {
 					// check if validator already voted on tx
 					if _, ok := usedValidator[i]; !ok {
-						signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
+						signedPower[sigObj.Result] = signedPower[sigObj.Result] + powers[i]
 						usedValidator[i] = true
+
+						if sigObj.Result == abci.SideTxResultType_Yes {
+							signedRawYesPower = signedRawYesPower + rawPowers[i]
+						}
 					}
 				}
 			}
 
+			// checkpoint yes votes are signatures checked against raw stake on root chain,
+			// capped power alone can't approve them
+			approved := signedPower[abci.SideTxResultType_Yes] >= (totalPower*2/3 + 1)
+			if approved && app.hasCheckpointMsg(tx) {
+				approved = signedRawYesPower >= (totalRawPower*2/3 + 1)
+			}
+
 			var result sdk.Result
 
 			// check vote majority
-			if signedPower[abci.SideTxResultType_Yes] >= (totalPower*2/3 + 1) {
+			if approved {
 				// approved
 				logger.Debug("[sidechannel] Approved side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
@@ -198,6 +219,23 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 // Internal functions
 //
 
+// hasCheckpointMsg returns true if tx carries a checkpoint module msg
+func (app *HeimdallApp) hasCheckpointMsg(txBytes []byte) bool {
+	decoder := authTypes.DefaultTxDecoder(app.cdc)
+	tx, err := decoder(txBytes)
+	if err != nil {
+		return false
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if msg.Route() == checkpointTypes.RouterKey {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (app *HeimdallApp) runTx(ctx sdk.Context, txBytes []byte, sideTxResult abci.SideTxResultType) (result sdk.Result) {
 	// get decoder
 	decoder := authTypes.DefaultTxDecoder(app.cdc)
@@ -13,6 +13,7 @@ import (
 
 	app "github.com/maticnetwork/heimdall/app"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -503,6 +504,70 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 	})
 }
 
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerCappedPower() {
+	t, happ, ctx, encoder := suite.T(), suite.app, suite.ctx, suite.encoder
+
+	var height int64 = 20
+	ctx = ctx.WithBlockHeight(height)
+
+	// largest validator is capped from 40 to 25, 60 of 85 capped power votes yes
+	params := happ.StakingKeeper.GetParams(ctx)
+	params.MaxValidatorPowerPercent = 30
+	happ.StakingKeeper.SetParams(ctx, params)
+
+	addrs := [][]byte{[]byte("hello-1"), []byte("hello-2"), []byte("hello-3"), []byte("hello-4")}
+	happ.SidechannelKeeper.SetValidators(ctx, height, []abci.Validator{
+		{Address: addrs[0], Power: 10},
+		{Address: addrs[1], Power: 20},
+		{Address: addrs[2], Power: 30},
+		{Address: addrs[3], Power: 40},
+	})
+
+	sigs := []abci.SideTxSig{
+		{Result: abci.SideTxResultType_Yes, Address: addrs[0]},
+		{Result: abci.SideTxResultType_Yes, Address: addrs[1]},
+		{Result: abci.SideTxResultType_Yes, Address: addrs[2]},
+	}
+
+	testCases := []struct {
+		name     string
+		msg      sdk.Msg
+		expected abci.SideTxResultType
+	}{
+		{"CappedPowerMajority", msgSideCounter{Counter: 1}, abci.SideTxResultType_Yes},
+		{"CheckpointWithoutRawPowerMajority", msgSideCheckpoint{msgSideCounter{Counter: 1}}, abci.SideTxResultType_Skip},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBytes, err := encoder(hmTypes.BaseTx{Msg: tc.msg})
+			require.Nil(t, err, "There should be no error while encoding tx")
+
+			var sideTxResult abci.SideTxResultType
+			router := hmTypes.NewSideRouter()
+			handler := &hmTypes.SideHandlers{
+				SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+					return abci.ResponseDeliverSideTx{}
+				},
+				PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, result abci.SideTxResultType) sdk.Result {
+					sideTxResult = result
+					return sdk.Result{}
+				},
+			}
+			router.AddRoute(tc.msg.Route(), handler)
+			happ.SetSideRouter(router)
+
+			happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+			happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{
+				SideTxResults: []abci.SideTxResult{
+					{TxHash: tmTypes.Tx(txBytes).Hash(), Sigs: sigs},
+				},
+			})
+			require.Equal(t, tc.expected, sideTxResult)
+		})
+	}
+}
+
 //
 // utils
 //
@@ -514,6 +579,7 @@ func registerTestCodec(cdc *codec.Codec) {
 	// register test types
 	cdc.RegisterConcrete(&msgCounter{}, "cosmos-sdk/baseapp/msgCounter", nil)
 	cdc.RegisterConcrete(&msgSideCounter{}, "cosmos-sdk/baseapp/msgSideCounter", nil)
+	cdc.RegisterConcrete(&msgSideCheckpoint{}, "cosmos-sdk/baseapp/msgSideCheckpoint", nil)
 }
 
 const (
@@ -560,3 +626,10 @@ func (msg msgSideCounter) ValidateBasic() sdk.Error {
 	}
 	return sdk.ErrInvalidSequence("counter should be a non-negative integer.")
 }
+
+// msgSideCheckpoint side msg routed to checkpoint module
+type msgSideCheckpoint struct {
+	msgSideCounter
+}
+
+func (msg msgSideCheckpoint) Route() string { return checkpointTypes.RouterKey }
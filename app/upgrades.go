@@ -0,0 +1,53 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	featuremanagerUtil "github.com/maticnetwork/heimdall/featuremanager/util"
+)
+
+// Upgrade names, each is opened by featuremanager proposal with activation height of upgrade
+const (
+	TopupFeeSupply        = "TopupFeeSupply"
+	StakingQueueMigration = "StakingQueueMigration"
+)
+
+// upgrade migrates state once, at activation height of upgrade feature with same name
+type upgrade struct {
+	Name    string
+	Handler func(ctx sdk.Context)
+}
+
+// upgrades returns state upgrades, each is registered as featuremanager upgrade feature
+func (app *HeimdallApp) upgrades() []upgrade {
+	return []upgrade{
+		{
+			Name: TopupFeeSupply,
+			Handler: func(ctx sdk.Context) {
+				app.TopupKeeper.InitFeeSupply(ctx)
+			},
+		},
+		{
+			Name: StakingQueueMigration,
+			Handler: func(ctx sdk.Context) {
+				app.StakingKeeper.MigrateStakingQueues(ctx)
+			},
+		},
+	}
+}
+
+// applyUpgrades runs upgrades whose feature is opened at current height,
+// featuremanager rejects changes of applied upgrades so every upgrade runs once
+func (app *HeimdallApp) applyUpgrades(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+
+	for _, u := range app.upgrades() {
+		feature := featuremanagerUtil.GetFeatureConfig().GetRawFeature(ctx, u.Name)
+		if !feature.IsOpen || feature.ActivationHeight != height {
+			continue
+		}
+
+		logger.Info("Applying upgrade", "name", u.Name, "height", height)
+		u.Handler(ctx)
+	}
+}
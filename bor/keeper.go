@@ -14,6 +14,8 @@ import (
 	chainmanager "github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -43,6 +45,8 @@ type Keeper struct {
 	contractCaller helper.ContractCaller
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// slashing keeper
+	slashingKeeper slashing.Keeper
 }
 
 // NewKeeper create new keeper
@@ -53,6 +57,7 @@ func NewKeeper(
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
 	stakingKeeper staking.Keeper,
+	slashingKeeper slashing.Keeper,
 	caller helper.ContractCaller,
 ) Keeper {
 	// create keeper
@@ -63,6 +68,7 @@ func NewKeeper(
 		codespace:      codespace,
 		chainKeeper:    chainKeeper,
 		sk:             stakingKeeper,
+		slashingKeeper: slashingKeeper,
 		contractCaller: caller,
 	}
 	return keeper
@@ -219,11 +225,18 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []hmTypes.Validator, err error) {
 	// spanEligibleVals are current validators who are not getting deactivated in between next span
 	spanEligibleVals := k.sk.GetSpanEligibleValidators(ctx)
-	producerCount := k.GetParams(ctx).ProducerCount
+	params := k.GetParams(ctx)
+	producerCount := params.ProducerCount
 	if err != nil {
 		return vals, err
 	}
 
+	// weight by recent signing performance, validators left without weight are not selected
+	var uptimeWeights []int64
+	if params.ProducerUptimeBlocks > 0 {
+		spanEligibleVals, uptimeWeights = k.GetUptimeWeightedValidators(ctx, spanEligibleVals, params.ProducerUptimeBlocks)
+	}
+
 	// if producers to be selected is more than current validators no need to select/shuffle
 	if len(spanEligibleVals) <= int(producerCount) {
 		return spanEligibleVals, nil
@@ -236,6 +249,20 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []
 		fn = XXXSelectNextProducers
 	}
 
+	// select by effective power, single validator power is capped by max validator power percent
+	powers := make([]int64, len(spanEligibleVals))
+	for i, val := range spanEligibleVals {
+		powers[i] = val.VotingPower
+	}
+
+	if uptimeWeights != nil {
+		powers = uptimeWeights
+	}
+
+	for i, power := range k.sk.CapValidatorPowers(ctx, powers) {
+		spanEligibleVals[i].VotingPower = power
+	}
+
 	newProducersIds, err := fn(seed, spanEligibleVals, producerCount)
 	if err != nil {
 		return vals, err
@@ -257,6 +284,43 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []
 	return vals, nil
 }
 
+// GetUptimeWeightedValidators returns validators with their power weighted by uptime over recent blocks.
+// Jailed validators and validators which signed nothing get no weight and are left out. If no validator
+// has weight, all are returned weighted by power so span still gets producers.
+func (k *Keeper) GetUptimeWeightedValidators(ctx sdk.Context, validators []hmTypes.Validator, uptimeBlocks uint64) ([]hmTypes.Validator, []int64) {
+	fromHeight := ctx.BlockHeight() - int64(uptimeBlocks)
+
+	weighted := make([]hmTypes.Validator, 0, len(validators))
+	weights := make([]int64, 0, len(validators))
+
+	for _, val := range validators {
+		if slashInfo, found := k.slashingKeeper.GetBufferValSlashingInfo(ctx, val.ID); val.Jailed || (found && slashInfo.IsJailed) {
+			continue
+		}
+
+		history := k.slashingKeeper.GetValidatorUptimeSince(ctx, val.ID, fromHeight)
+		uptime := slashingTypes.NewValidatorUptimeSummary(val.ID, history).Uptime
+
+		if weight := UptimeWeightedPower(val.VotingPower, uptime); weight > 0 {
+			weighted = append(weighted, val)
+			weights = append(weights, weight)
+		}
+	}
+
+	if len(weighted) == 0 {
+		k.Logger(ctx).Error("No validator has uptime weight, selecting by power")
+
+		weights = make([]int64, len(validators))
+		for i, val := range validators {
+			weights[i] = val.VotingPower
+		}
+
+		return validators, weights
+	}
+
+	return weighted, weights
+}
+
 // UpdateLastSpan updates the last span start block
 func (k *Keeper) UpdateLastSpan(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,121 @@
+package bor_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type UptimeSelectionTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *UptimeSelectionTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 400000})
+
+	ctx := suite.ctx
+	chSim.LoadAccountValidators(4, suite.T(), suite.app.StakingKeeper, ctx)
+
+	// validator 2 signed half of recent blocks, validator 3 is jailed in slashing buffer
+	uptime := slashingTypes.NewValidatorUptime(399000, 399999)
+	uptime.Signed, uptime.Missed = 500, 500
+	suite.app.SlashingKeeper.SetValidatorUptime(ctx, hmTypes.NewValidatorID(2), uptime)
+	suite.app.SlashingKeeper.SetBufferValSlashingInfo(ctx, hmTypes.NewValidatorID(3), hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(3), 40, true))
+
+	params := suite.app.BorKeeper.GetParams(ctx)
+	params.ProducerCount = 2
+	params.ProducerUptimeBlocks = 2000
+	suite.app.BorKeeper.SetParams(ctx, params)
+}
+
+func TestUptimeSelectionTestSuite(t *testing.T) {
+	suite.Run(t, new(UptimeSelectionTestSuite))
+}
+
+func (suite *UptimeSelectionTestSuite) TestGetUptimeWeightedValidators() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.BorKeeper
+
+	validators, weights := keeper.GetUptimeWeightedValidators(ctx, suite.app.StakingKeeper.GetSpanEligibleValidators(ctx), 2000)
+	require.Len(t, validators, 3)
+
+	weightByID := make(map[hmTypes.ValidatorID]int64)
+	for i, validator := range validators {
+		weightByID[validator.ID] = weights[i]
+		require.Equal(t, int64(100), validator.VotingPower)
+	}
+
+	require.Equal(t, map[hmTypes.ValidatorID]int64{1: 100, 2: 50, 4: 100}, weightByID)
+
+	// uptime of periods ended before window is not counted
+	uptime := slashingTypes.NewValidatorUptime(390000, 390999)
+	uptime.Missed = 1000
+	suite.app.SlashingKeeper.SetValidatorUptime(ctx, hmTypes.NewValidatorID(1), uptime)
+
+	validators, weights = keeper.GetUptimeWeightedValidators(ctx, suite.app.StakingKeeper.GetSpanEligibleValidators(ctx), 2000)
+	for i, validator := range validators {
+		weightByID[validator.ID] = weights[i]
+	}
+
+	require.Equal(t, map[hmTypes.ValidatorID]int64{1: 100, 2: 50, 4: 100}, weightByID)
+}
+
+func (suite *UptimeSelectionTestSuite) TestUptimePeriodLongerThanWindow() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.BorKeeper
+
+	// period in progress started before window
+	uptime := slashingTypes.NewValidatorUptime(395000, 404999)
+	uptime.Signed, uptime.Missed = 1000, 3000
+	suite.app.SlashingKeeper.SetValidatorUptime(ctx, hmTypes.NewValidatorID(4), uptime)
+
+	validators, weights := keeper.GetUptimeWeightedValidators(ctx, suite.app.StakingKeeper.GetSpanEligibleValidators(ctx), 500)
+	require.Len(t, validators, 3)
+
+	weightByID := make(map[hmTypes.ValidatorID]int64)
+	for i, validator := range validators {
+		weightByID[validator.ID] = weights[i]
+	}
+
+	require.Equal(t, map[hmTypes.ValidatorID]int64{1: 100, 2: 50, 4: 25}, weightByID)
+}
+
+func (suite *UptimeSelectionTestSuite) TestSelectNextProducers() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.BorKeeper
+
+	for i := 0; i < 100; i++ {
+		seed := common.BigToHash(common.Big1.Lsh(common.Big1, uint(i)))
+
+		producers, err := keeper.SelectNextProducers(ctx, seed)
+		require.NoError(t, err)
+
+		for _, producer := range producers {
+			require.NotEqual(t, hmTypes.NewValidatorID(3), producer.ID)
+		}
+	}
+}
+
+func (suite *UptimeSelectionTestSuite) TestAllJailed() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.BorKeeper
+
+	validators := suite.app.StakingKeeper.GetSpanEligibleValidators(ctx)
+	for _, validator := range validators {
+		suite.app.SlashingKeeper.SetBufferValSlashingInfo(ctx, validator.ID, hmTypes.NewValidatorSlashingInfo(validator.ID, 40, true))
+	}
+
+	// selection falls back to power so span still gets producers
+	weighted, weights := keeper.GetUptimeWeightedValidators(ctx, validators, 2000)
+	require.Len(t, weighted, len(validators))
+	require.Equal(t, []int64{100, 100, 100, 100}, weights)
+}
@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/helper"
@@ -42,6 +43,24 @@ func convertToSlots(vals []hmTypes.Validator) (validatorIndices []uint64) {
 	return validatorIndices
 }
 
+// UptimeWeightedPower scales power by signing uptime, validators which signed anything keep at least one
+func UptimeWeightedPower(power int64, uptime sdk.Dec) int64 {
+	if power <= 0 || !uptime.IsPositive() {
+		return 0
+	}
+
+	if uptime.GTE(sdk.OneDec()) {
+		return power
+	}
+
+	weight := uptime.MulInt64(power).TruncateInt64()
+	if weight == 0 {
+		return 1
+	}
+
+	return weight
+}
+
 //
 // New selection algorithm
 //
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/maticnetwork/heimdall/types"
@@ -236,6 +237,59 @@ func SimulateSelectionDistributionCorrectness() {
 	}
 }
 
+func TestUptimeWeightedPower(t *testing.T) {
+	tests := []struct {
+		power  int64
+		uptime sdk.Dec
+		weight int64
+	}{
+		{power: 1000, uptime: sdk.OneDec(), weight: 1000},
+		{power: 1000, uptime: sdk.NewDecWithPrec(5, 1), weight: 500},
+		{power: 1000, uptime: sdk.ZeroDec(), weight: 0},
+		{power: 1, uptime: sdk.NewDecWithPrec(1, 1), weight: 1},
+		{power: 0, uptime: sdk.OneDec(), weight: 0},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.weight, UptimeWeightedPower(tt.power, tt.uptime), "power %v uptime %v", tt.power, tt.uptime)
+	}
+}
+
+func TestUptimeWeightedSelectionDistribution(t *testing.T) {
+	uptimes := []sdk.Dec{sdk.ZeroDec(), sdk.OneDec(), sdk.OneDec(), sdk.NewDecWithPrec(5, 1)}
+
+	var validators []hmTypes.Validator
+	for i, uptime := range uptimes {
+		validators = append(validators, hmTypes.Validator{ID: hmTypes.NewValidatorID(uint64(i + 1)), VotingPower: UptimeWeightedPower(1000, uptime)})
+	}
+
+	producerSlots := uint64(3)
+	iterations := uint64(20000)
+	buffer := make([]byte, 8)
+	selectedTimes := make(map[types.ValidatorID]uint64)
+
+	for i := uint64(1); i <= iterations; i++ {
+		binary.BigEndian.PutUint64(buffer, i)
+		hash := common.BytesToHash(crypto.Keccak256(buffer))
+
+		producerIds, err := SelectNextProducers(hash, validators, producerSlots)
+		require.NoError(t, err)
+
+		// same seed selects same producers
+		again, _ := SelectNextProducers(hash, validators, producerSlots)
+		require.Equal(t, producerIds, again)
+
+		for _, id := range producerIds {
+			selectedTimes[types.ValidatorID(id)]++
+		}
+	}
+
+	// validator without uptime is never selected, half uptime halves selection probability
+	require.Zero(t, selectedTimes[1])
+	ratio := float64(selectedTimes[4]) / float64(selectedTimes[2])
+	require.InDelta(t, 0.5, ratio, 0.05)
+}
+
 func Test_binarySearch(t *testing.T) {
 	type args struct {
 		array  []uint64
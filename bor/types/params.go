@@ -26,15 +26,19 @@ var (
 	KeySprintDuration = []byte("SprintDuration")
 	KeySpanDuration   = []byte("SpanDuration")
 	KeyProducerCount  = []byte("ProducerCount")
+
+	KeyProducerUptimeBlocks = []byte("ProducerUptimeBlocks")
 )
 
-var _ subspace.ParamSet = &Params{}
+var _ subspace.OptionalParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
 	SprintDuration uint64 `json:"sprint_duration" yaml:"sprint_duration"` // sprint duration
 	SpanDuration   uint64 `json:"span_duration" yaml:"span_duration"`     // span duration ie number of blocks for which val set is frozen on heimdall
 	ProducerCount  uint64 `json:"producer_count" yaml:"producer_count"`   // producer count per span
+
+	ProducerUptimeBlocks uint64 `json:"producer_uptime_blocks,omitempty" yaml:"producer_uptime_blocks"` // recent blocks signing performance weights producer selection over, zero disables
 }
 
 // NewParams creates a new Params object
@@ -57,6 +61,13 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	}
 }
 
+// OptionalParamSetPairs implements the OptionalParamSet interface and returns params added after chain start.
+func (p *Params) OptionalParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyProducerUptimeBlocks, Value: &p.ProducerUptimeBlocks},
+	}
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
@@ -71,6 +82,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("SprintDuration: %d\n", p.SprintDuration))
 	sb.WriteString(fmt.Sprintf("SpanDuration: %d\n", p.SpanDuration))
 	sb.WriteString(fmt.Sprintf("ProducerCount: %d\n", p.ProducerCount))
+	sb.WriteString(fmt.Sprintf("ProducerUptimeBlocks: %d\n", p.ProducerUptimeBlocks))
 	return sb.String()
 }
 
@@ -97,7 +109,8 @@ func (p Params) Validate() error {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().
+		RegisterParamSet(&Params{})
 }
 
 // DefaultParams returns a default set of parameters.
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func queryCheckpointPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-policy [root-chain-type]",
+		Short: "show cost-aware checkpoint policy status for one chain or all",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext()
+
+			var statuses []util.CheckpointCostStatus
+			if len(args) == 1 {
+				statuses = []util.CheckpointCostStatus{util.GetCheckpointCostStatus(cliCtx, &contractCaller, args[0])}
+			} else {
+				if statuses, err = util.GetCheckpointCostStatuses(cliCtx, &contractCaller); err != nil {
+					return err
+				}
+			}
+
+			for _, status := range statuses {
+				msg, err := json.Marshal(status)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(msg))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(queryCheckpointPolicyCmd())
+}
@@ -149,6 +149,11 @@ func (rl *RootChainListener) ProcessHeader(newBlockHeader *blockHeader) {
 			return
 		}
 	}
+	// skip paused or retired chain, events are queried from last block once chain resumes
+	if !util.IsChainActive(rl.cliCtx, rl.rootChainType) {
+		rl.Logger.Debug("Root chain is not active", "root", rl.rootChainType)
+		return
+	}
 	// fetch context
 	rootchainContext, err := rl.getRootChainContext()
 	if err != nil {
@@ -259,6 +264,10 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
 						rl.sendTaskWithDelay("sendStakingAckToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
+				case "CheckpointSyncAck":
+					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+						rl.sendTaskWithDelay("sendCheckpointSyncAckToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
 				}
 			}
 		}
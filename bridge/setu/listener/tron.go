@@ -278,6 +278,16 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 						tl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
+				case "ShareMinted":
+					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
+						tl.sendTaskWithDelay("sendShareMintedToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
+
+				case "ShareBurned":
+					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
+						tl.sendTaskWithDelay("sendShareBurnedToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
+
 				case "TopUpFee":
 					event := new(stakinginfo.StakinginfoTopUpFee)
 					if err := helper.UnpackLog(tl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
@@ -314,6 +324,11 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
 						tl.sendTaskWithDelay("sendAddNewChainToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
+
+				case "ChainStatusChanged":
+					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
+						tl.sendTaskWithDelay("sendChainStatusToHeimdall", selectedEvent.Name, logBytes, delay)
+					}
 				}
 			}
 		}
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -28,6 +29,9 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// queuedCheckpointRetryDelay delay before retrying checkpoint waiting behind pending checkpoints
+const queuedCheckpointRetryDelay = 1 * time.Minute
+
 // CheckpointProcessor - processor for checkpoint queue.
 type CheckpointProcessor struct {
 	BaseProcessor
@@ -53,6 +57,12 @@ type CheckpointContext struct {
 	CheckpointParams   *checkpointTypes.Params
 }
 
+// ChainCheckpointParams returns checkpoint params with overrides of root chain applied
+func (c *CheckpointContext) ChainCheckpointParams(rootChain string) *checkpointTypes.Params {
+	params := c.CheckpointParams.ForRootChain(rootChain)
+	return &params
+}
+
 // NewCheckpointProcessor - add rootchain abi to checkpoint processor
 func NewCheckpointProcessor(rootchainAbi, stakingInfoAbi *abi.ABI) *CheckpointProcessor {
 	checkpointProcessor := &CheckpointProcessor{
@@ -93,6 +103,9 @@ func (cp *CheckpointProcessor) RegisterTasks() {
 	if err := cp.queueConnector.Server.RegisterTask("sendAddNewChainToHeimdall", cp.sendAddNewChainToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendAddNewChainToHeimdall", "error", err)
 	}
+	if err := cp.queueConnector.Server.RegisterTask("sendChainStatusToHeimdall", cp.sendChainStatusToHeimdall); err != nil {
+		cp.Logger.Error("RegisterTasks | sendChainStatusToHeimdall", "error", err)
+	}
 }
 
 func (cp *CheckpointProcessor) startPolling(ctx context.Context) {
@@ -176,6 +189,10 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 					continue
 				}
 			}
+			if !util.IsChainActive(cp.cliCtx, root) {
+				cp.Logger.Debug("Root chain is paused or retired", "root", root)
+				continue
+			}
 			// fetch checkpoint context for different chain
 			checkpointContext, err := cp.getCheckpointContext(root)
 			if err != nil {
@@ -197,16 +214,18 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 			//
 			// Check checkpoint buffer
 			//
-			timeStamp := uint64(time.Now().Unix())
-			checkpointBufferTime := uint64(checkpointContext.CheckpointParams.CheckpointBufferTime.Seconds())
+			lastPending, full := cp.checkPendingCheckpoints(checkpointContext, root)
+			if full {
+				cp.Logger.Info("Checkpoint already exits in buffer", "root", root, "Checkpoint", lastPending.String())
+				continue
+			}
 
-			bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx, root)
-			if err != nil {
-				cp.Logger.Debug("No buffered checkpoint", "root", root, "bufferedCheckpoint", bufferedCheckpoint)
+			if lastPending != nil {
+				// queue next checkpoint behind the ones waiting for ack
+				start, end = cp.nextPipelinedCheckpoint(checkpointContext, *lastPending, latestConfirmedChildBlock, root)
 			}
 
-			if bufferedCheckpoint != nil && !(bufferedCheckpoint.TimeStamp == 0 || ((timeStamp > bufferedCheckpoint.TimeStamp) && timeStamp-bufferedCheckpoint.TimeStamp >= checkpointBufferTime)) {
-				cp.Logger.Info("Checkpoint already exits in buffer", "root", root, "Checkpoint", bufferedCheckpoint.String())
+			if cp.deferCheckpoint(start, end, root) {
 				continue
 			}
 
@@ -292,12 +311,16 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 				cp.Logger.Error("Error sending checkpoint to rootchain", "error", err)
 				return err
 			}
+		} else if cp.isCheckpointQueued(startBlock, rootChain) {
+			cp.requeueCheckpointToRootchain(eventBytes, blockHeight, rootChain)
+			return nil
 		} else {
 			cp.Logger.Info("Checkpoint has already sent. Ignoring", "eventType", event.Type)
 			return nil
 		}
 
-	case hmTypes.RootChainTypeBsc, hmTypes.RootChainTypeEth:
+	default:
+		// evm root chains, including ones added through chainmanager
 		shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, startBlock, endBlock, rootChain)
 		if err != nil {
 			return err
@@ -309,15 +332,13 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 				cp.Logger.Error("Error sending checkpoint to rootchain", "root", rootChain, "error", err)
 				return err
 			}
+		} else if cp.isCheckpointQueued(startBlock, rootChain) {
+			cp.requeueCheckpointToRootchain(eventBytes, blockHeight, rootChain)
+			return nil
 		} else {
 			cp.Logger.Info("Checkpoint has already sent. Ignoring", "root", rootChain, "eventType", event.Type)
 			return nil
 		}
-
-	default:
-		err := fmt.Errorf("Unknown rootchain: %s", rootChain)
-		cp.Logger.Error("Error sending checkpoint to rootchain", "root", rootChain, "error", err)
-		return err
 	}
 
 	return nil
@@ -342,7 +363,7 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 	if err := helper.UnpackLog(cp.rootchainAbi, event, eventName, &log); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		checkpointNumber := big.NewInt(0).Div(event.HeaderBlockId, big.NewInt(0).SetUint64(checkpointContext.CheckpointParams.ChildBlockInterval))
+		checkpointNumber := big.NewInt(0).Div(event.HeaderBlockId, big.NewInt(0).SetUint64(checkpointContext.ChainCheckpointParams(rootChain).ChildBlockInterval))
 
 		cp.Logger.Info(
 			"✅ Received task to send checkpoint-ack to heimdall",
@@ -428,7 +449,7 @@ func (cp *CheckpointProcessor) handleCheckpointNoAck() {
 
 func (cp *CheckpointProcessor) getCurrentCheckpoint(checkpointContext *CheckpointContext, rootChain string) (*CheckpointInfo, error) {
 	chainmanagerParams := checkpointContext.ChainmanagerParams
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
 
 	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainmanagerParams.ChainParams.RootChainAddress.EthAddress(), rootChain)
 	if err != nil {
@@ -461,7 +482,7 @@ func (cp *CheckpointProcessor) getCurrentCheckpoint(checkpointContext *Checkpoin
 
 // nextExpectedCheckpoint - fetched contract checkpoint state and returns the next probable checkpoint that needs to be sent
 func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *CheckpointContext, latestChildBlock uint64, rootChain string) (*ContractCheckpoint, error) {
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
 	// get header info
 	currentCheckpointInfo, err := cp.getCurrentCheckpoint(checkpointContext, rootChain)
 	if err != nil {
@@ -533,6 +554,103 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 	}), nil
 }
 
+// checkPendingCheckpoints returns last checkpoint waiting for ack on heimdall and whether no more checkpoints can be queued,
+// nil if nothing is waiting or buffered checkpoint is timed out
+func (cp *CheckpointProcessor) checkPendingCheckpoints(checkpointContext *CheckpointContext, rootChain string) (*hmTypes.Checkpoint, bool) {
+	pending, err := util.GetPendingCheckpoints(cp.cliCtx, rootChain)
+	if err != nil || len(pending) == 0 {
+		cp.Logger.Debug("No buffered checkpoint", "root", rootChain)
+		return nil, false
+	}
+
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
+	timeStamp := uint64(time.Now().Unix())
+	checkpointBufferTime := uint64(checkpointParams.CheckpointBufferTime.Seconds())
+
+	// heimdall flushes timed out buffer along with queued checkpoints
+	bufferedCheckpoint := pending[0]
+	if bufferedCheckpoint.TimeStamp == 0 || ((timeStamp > bufferedCheckpoint.TimeStamp) && timeStamp-bufferedCheckpoint.TimeStamp >= checkpointBufferTime) {
+		return nil, false
+	}
+
+	return &pending[len(pending)-1], uint64(len(pending)) >= checkpointParams.PendingCheckpointLimit()
+}
+
+// nextPipelinedCheckpoint returns start/end of checkpoint queued behind last one waiting for ack,
+// end is zero until enough blocks are there since queued checkpoints are never force pushed
+func (cp *CheckpointProcessor) nextPipelinedCheckpoint(checkpointContext *CheckpointContext, lastPending hmTypes.Checkpoint, latestChildBlock uint64, rootChain string) (uint64, uint64) {
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
+	start := lastPending.EndBlock + 1
+
+	if latestChildBlock < start {
+		return start, 0
+	}
+
+	diff := latestChildBlock - start + 1
+	if diff < checkpointParams.AvgCheckpointLength {
+		cp.Logger.Debug("Waiting for blocks to queue checkpoint", "start", start, "latest", latestChildBlock, "root", rootChain)
+		return start, 0
+	}
+
+	expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength - 1
+	// cap with max checkpoint length
+	if expectedDiff > checkpointParams.MaxCheckpointLength-1 {
+		expectedDiff = checkpointParams.MaxCheckpointLength - 1
+	}
+
+	end := start + expectedDiff
+	if !cp.checkCrossChain(start, end, rootChain, checkpointParams.MaxCheckpointLength) {
+		return start, 0
+	}
+
+	cp.Logger.Debug("Calculating queued checkpoint", "latest", latestChildBlock, "start", start, "end", end, "root", rootChain)
+
+	return start, end
+}
+
+// isCheckpointQueued returns true if checkpoint is waiting for ack on heimdall behind another checkpoint
+func (cp *CheckpointProcessor) isCheckpointQueued(start uint64, rootChain string) bool {
+	pending, err := util.GetPendingCheckpoints(cp.cliCtx, rootChain)
+	if err != nil {
+		return false
+	}
+
+	for i := 1; i < len(pending); i++ {
+		if pending[i].StartBlock == start {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requeueCheckpointToRootchain retries queued checkpoint later, it can be submitted once checkpoints ahead of it land on root chain
+func (cp *CheckpointProcessor) requeueCheckpointToRootchain(eventBytes string, blockHeight int64, rootChain string) {
+	// create machinery task
+	signature := &tasks.Signature{
+		Name: "sendCheckpointToRootchain",
+		Args: []tasks.Arg{
+			{
+				Type:  "string",
+				Value: eventBytes,
+			},
+			{
+				Type:  "int64",
+				Value: blockHeight,
+			},
+		},
+	}
+	signature.RetryCount = 3
+	signature.RetryTimeout = 3
+	eta := time.Now().Add(queuedCheckpointRetryDelay)
+	signature.ETA = &eta
+
+	cp.Logger.Info("Checkpoint queued behind pending checkpoints. Retrying later", "root", rootChain, "blockHeight", blockHeight, "retryTime", eta)
+	if _, err := cp.queueConnector.Server.SendTask(signature); err != nil {
+		cp.Logger.Error("Error requeueing checkpoint to rootchain", "root", rootChain, "error", err)
+	}
+}
+
 func (cp *CheckpointProcessor) checkCrossChain(start uint64, end uint64, rootChain string,
 	maxCheckpointLengthParam uint64,
 ) bool {
@@ -556,6 +674,29 @@ func (cp *CheckpointProcessor) checkCrossChain(start uint64, end uint64, rootCha
 	return cp.hasCrossChainTx(start, end, rootChain)
 }
 
+// deferCheckpoint checks if checkpoint can wait for cheaper root chain cost.
+// checkpoints carrying cross-chain withdrawals are never deferred.
+func (cp *CheckpointProcessor) deferCheckpoint(start uint64, end uint64, rootChain string) bool {
+	if enabled, _, _ := util.GetCheckpointCostPolicy(); !enabled || end == 0 || start >= end {
+		return false
+	}
+
+	status := util.GetCheckpointCostStatus(cp.cliCtx, &cp.contractConnector, rootChain)
+	if status.Error != "" {
+		cp.Logger.Error("Error while fetching checkpoint cost", "root", rootChain, "error", status.Error)
+		return false
+	}
+
+	if !status.Deferring || cp.hasCrossChainTx(start, end, rootChain) {
+		return false
+	}
+
+	cp.Logger.Info("Deferring checkpoint, root chain cost is high", "root", rootChain, "start", start, "end", end,
+		"costPercent", status.CostPercent, "threshold", status.Threshold, "lastCheckpointAge", status.LastCheckpointAge)
+
+	return true
+}
+
 // sendCheckpointToHeimdall - creates checkpoint msg and broadcasts to heimdall
 func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointContext *CheckpointContext, start, end uint64, rootChain string) error {
 	cp.Logger.Debug("Initiating checkpoint to Heimdall", "root", rootChain, "start", start, "end", end)
@@ -566,6 +707,10 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 	}
 	// fetch latest checkpoint
 	latestCheckpoint, err := util.GetlastestCheckpoint(cp.cliCtx, rootChain)
+	// checkpoints waiting for ack come after latest checkpoint
+	if pending, pendingErr := util.GetPendingCheckpoints(cp.cliCtx, rootChain); pendingErr == nil && len(pending) != 0 {
+		latestCheckpoint, err = &pending[len(pending)-1], nil
+	}
 	// event checkpoint is older than or equal to latest checkpoint
 	if err == nil && latestCheckpoint != nil && latestCheckpoint.EndBlock+1 < start {
 		cp.Logger.Debug("Need to resubmit Checkpoint ack first", "start", start, "last_end", latestCheckpoint.EndBlock)
@@ -578,7 +723,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 	}
 
 	// get checkpoint params
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
 
 	// Get root hash
 	root, err := cp.contractConnector.GetRootHash(start, end, checkpointParams.MaxCheckpointLength)
@@ -671,15 +816,17 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(
 	}
 
 	if shouldSend {
-		// chain manager params
-		chainParams := checkpointContext.ChainmanagerParams.ChainParams
-		// root chain instance
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainParams.RootChainAddress.EthAddress(), rootChain)
+		adapter, err := helper.GetRootChainAdapter(&cp.contractConnector, rootChain)
 		if err != nil {
-			cp.Logger.Info("Error while creating rootchain instance", "error", err)
+			cp.Logger.Info("Error while fetching root chain adapter", "root", rootChain, "error", err)
 			return err
 		}
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, chainParams.RootChainAddress.EthAddress(), rootChainInstance, rootChain); err != nil {
+		contracts, err := util.GetRootChainContracts(cp.cliCtx, rootChain)
+		if err != nil {
+			cp.Logger.Error("Error while fetching root chain contracts", "root", rootChain, "error", err)
+			return err
+		}
+		if err := adapter.SendCheckpoint(sideTxData, sigs, contracts); err != nil {
 			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
@@ -708,7 +855,7 @@ func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountroothash hmTyp
 func (cp *CheckpointProcessor) resubmitCheckpointAck(checkpointContext *CheckpointContext, rootChain string) error {
 	// get chain params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
 
 	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainParams.RootChainAddress.EthAddress(), rootChain)
 	if err != nil {
@@ -849,7 +996,7 @@ func (cp *CheckpointProcessor) checkIfNoAckIsRequired(checkpointContext *Checkpo
 	}
 
 	// checkpoint params
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(hmTypes.RootChainTypeStake)
 
 	// check if difference between no-ack time and current time
 	lastNoAck := cp.getLastNoAckTime()
@@ -934,17 +1081,40 @@ func (cp *CheckpointProcessor) sendAddNewChainToHeimdall(eventName string, newCh
 	if err := helper.UnpackLog(cp.rootchainAbi, event, eventName, &log); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		var rootChain string
-		switch event.RootChainId.Uint64() {
-		case uint64(hmTypes.GetRootChainID(hmTypes.RootChainTypeBsc)):
-			if cp.getCheckpointActivationHeight(cp.cliCtx, hmTypes.RootChainTypeBsc) > 0 {
-				cp.Logger.Error("bsc has been connected to the network", "root", event.RootChainId.Uint64())
+		rootChain := event.RootChainType
+		if rootChain == "" {
+			cp.Logger.Error("New chain event has no root chain type", "rootChainID", event.RootChainId.Uint64())
+			return nil
+		}
+
+		rootChainIDs, err := util.GetRootChainIDMap(cp.cliCtx)
+		if err != nil {
+			cp.Logger.Error("Error while fetching root chain ids", "error", err)
+			return err
+		}
+
+		// chain already registered in chain manager must keep its id, otherwise it gets next unused id
+		if rootChainID, ok := rootChainIDs[rootChain]; ok {
+			if uint64(rootChainID) != event.RootChainId.Uint64() {
+				cp.Logger.Error("Root chain id from tron doesn't match chain manager", "root", rootChain, "rootChainID", rootChainID, "eventRootChainID", event.RootChainId.Uint64())
+				return nil
+			}
+
+			if cp.getCheckpointActivationHeight(cp.cliCtx, rootChain) > 0 {
+				cp.Logger.Error("Root chain has been connected to the network", "root", rootChain)
+				return nil
+			}
+		} else {
+			nextRootChainID, err := util.GetNextRootChainID(cp.cliCtx)
+			if err != nil {
+				cp.Logger.Error("Error while fetching next root chain id", "error", err)
+				return err
+			}
+
+			if uint64(nextRootChainID) != event.RootChainId.Uint64() {
+				cp.Logger.Error("Root chain id from tron is not the next root chain id", "root", rootChain, "nextRootChainID", nextRootChainID, "eventRootChainID", event.RootChainId.Uint64())
 				return nil
 			}
-			rootChain = hmTypes.RootChainTypeBsc
-		default:
-			cp.Logger.Error("Error root chain ID from tron", "root", rootChain, "error", err)
-			return nil
 		}
 		cp.Logger.Info(
 			"✅ Received task to send add new chain to heimdall",
@@ -985,6 +1155,83 @@ func (cp *CheckpointProcessor) sendAddNewChainToHeimdall(eventName string, newCh
 	return nil
 }
 
+// sendChainStatusToHeimdall - handles chain status change event from rootchain
+// 1. create and broadcast pause chain or retire chain msg to heimdall.
+func (cp *CheckpointProcessor) sendChainStatusToHeimdall(eventName string, chainStatusStr string, _ string) error {
+	cp.Logger.Info("Received sendChainStatusToHeimdall request", "chainStatusStr", chainStatusStr)
+
+	var log = types.Log{}
+	if err := json.Unmarshal([]byte(chainStatusStr), &log); err != nil {
+		cp.Logger.Error("Error while unmarshalling chain status event from tron", "error", err)
+		return err
+	}
+
+	event := new(rootchain.RootchainChainStatusChanged)
+	if err := helper.UnpackLog(cp.rootchainAbi, event, eventName, &log); err != nil {
+		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+		return nil
+	}
+
+	rootChainIDs, err := util.GetRootChainIDMap(cp.cliCtx)
+	if err != nil {
+		cp.Logger.Error("Error while fetching root chain ids", "error", err)
+		return err
+	}
+
+	var rootChain string
+	for root, rootChainID := range rootChainIDs {
+		if uint64(rootChainID) == event.RootChainId.Uint64() {
+			rootChain = root
+		}
+	}
+	status, ok := chainmanagerTypes.ChainStatusFromEvent[event.Status]
+	if rootChain == "" || rootChain == hmTypes.RootChainTypeStake || !ok {
+		cp.Logger.Error("Invalid chain status event from tron", "rootChainID", event.RootChainId.Uint64(), "status", event.Status)
+		return nil
+	}
+
+	cp.Logger.Info(
+		"✅ Received task to send chain status to heimdall",
+		"event", eventName,
+		"root", rootChain,
+		"status", status,
+		"effectiveHeight", event.EffectiveHeight.Uint64(),
+		"txHash", hmTypes.BytesToHeimdallHash(log.TxHash.Bytes()),
+		"logIndex", uint64(log.Index),
+		"blockNumber", log.BlockNumber,
+	)
+
+	var msg sdk.Msg
+	if status == chainmanagerTypes.ChainStatusRetired {
+		msg = chainmanagerTypes.NewMsgRetireChain(
+			helper.GetFromAddress(cp.cliCtx),
+			rootChain,
+			event.EffectiveHeight.Uint64(),
+			hmTypes.HeimdallHash(log.TxHash),
+			uint64(log.Index),
+			log.BlockNumber,
+		)
+	} else {
+		msg = chainmanagerTypes.NewMsgPauseChain(
+			helper.GetFromAddress(cp.cliCtx),
+			rootChain,
+			status == chainmanagerTypes.ChainStatusPaused,
+			event.EffectiveHeight.Uint64(),
+			hmTypes.HeimdallHash(log.TxHash),
+			uint64(log.Index),
+			log.BlockNumber,
+		)
+	}
+
+	// return broadcast to heimdall
+	if err := cp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		cp.Logger.Error("Error while broadcasting chain status to heimdall", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 // Stop stops all necessary go routines
 func (cp *CheckpointProcessor) Stop() {
 	// cancel No-Ack polling
@@ -17,67 +17,96 @@ import (
 )
 
 // handleCheckpointSync - Checkpoint Sync handler
-// 1. Fetch CheckpointSync from buffer
+// 1. Fetch CheckpointSync from buffer for every sync target
 // 2. check if elapsed.
 // 3. Send CheckpointSync to heimdall if required.
 func (cp *CheckpointProcessor) handleCheckpointSync() {
-	currentTime := time.Now().UTC()
+	checkpointParams, err := util.GetCheckpointParams(cp.cliCtx)
+	if err != nil {
+		cp.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return
+	}
+
+	rootChainIDs, err := util.GetRootChainIDMap(cp.cliCtx)
+	if err != nil {
+		cp.Logger.Error("Error while fetching root chain ids", "error", err)
+		return
+	}
 
-	for rootChain := range hmTypes.GetRootChainIDMap() {
-		if rootChain == hmTypes.RootChainTypeStake {
+	for _, targetChain := range checkpointParams.SyncTargets() {
+		if !util.IsChainActive(cp.cliCtx, targetChain) {
 			continue
 		}
-		// fetch fresh checkpoint context
-		checkpointContext, err := cp.getCheckpointContext(rootChain)
-		if err != nil {
-			return
+
+		contracts, err := cp.getSyncTargetContracts(targetChain)
+		if err != nil || contracts.StakingManagerAddress == "" {
+			cp.Logger.Debug("Sync target has no stake manager", "target", targetChain, "error", err)
+			continue
 		}
-		checkpointParams := checkpointContext.CheckpointParams
-		bufferedCheckpoint, err := util.GetBufferedCheckpointSync(cp.cliCtx, rootChain)
-		if err == nil {
-			bufferedTime := time.Unix(int64(bufferedCheckpoint.TimeStamp), 0)
-			if currentTime.Sub(bufferedTime).Seconds() < checkpointParams.CheckpointBufferTime.Seconds()/5 {
-				cp.Logger.Debug("Cannot send multiple checkpoint sync in short time", "error", err)
+
+		for rootChain := range rootChainIDs {
+			if rootChain == targetChain || !util.IsChainActive(cp.cliCtx, rootChain) {
 				continue
 			}
+			cp.checkAndSendCheckpointSync(rootChain, rootChainIDs[rootChain], targetChain)
 		}
+	}
+}
 
-		// fetch latest syncHeaderBlock from stakeChain
-		lastSyncedCheckpointNumber, err := cp.getLastSyncedCheckpointNumber(checkpointContext, rootChain)
-		if err != nil {
-			cp.Logger.Error("Error fetching syncedHeaderNumber from stakeChain", "root", rootChain, "error", err)
-			continue
-		}
-		nextSyncCheckpointNumber := lastSyncedCheckpointNumber + 1
-		// fetch nextHeaderBlock from rootChain
-		start, end, proposer, err := cp.getHeaderBlock(checkpointContext, nextSyncCheckpointNumber, rootChain)
-		if err != nil {
-			cp.Logger.Error("Error fetching currentHeaderBlock from rootChain", "root", rootChain, "error", err)
-			continue
-		}
+// checkAndSendCheckpointSync sends next checkpoint of root chain to be synced to target chain
+func (cp *CheckpointProcessor) checkAndSendCheckpointSync(rootChain string, rootChainID byte, targetChain string) {
+	currentTime := time.Now().UTC()
 
-		latestCheckpointInfo, err := cp.getCurrentCheckpoint(checkpointContext, rootChain)
-		if err != nil {
-			cp.Logger.Error("Error get latest checkpoint", "root", rootChain)
-			continue
+	// fetch fresh checkpoint context
+	checkpointContext, err := cp.getCheckpointContext(rootChain)
+	if err != nil {
+		return
+	}
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
+	bufferedCheckpoint, err := util.GetBufferedCheckpointSync(cp.cliCtx, rootChain, targetChain)
+	if err == nil {
+		bufferedTime := time.Unix(int64(bufferedCheckpoint.TimeStamp), 0)
+		if currentTime.Sub(bufferedTime).Seconds() < checkpointParams.CheckpointBufferTime.Seconds()/5 {
+			cp.Logger.Debug("Cannot send multiple checkpoint sync in short time", "root", rootChain, "target", targetChain)
+			return
 		}
+	}
+
+	// fetch latest syncHeaderBlock from target chain
+	lastSyncedCheckpointNumber, err := cp.getLastSyncedCheckpointNumber(rootChainID, targetChain)
+	if err != nil {
+		cp.Logger.Error("Error fetching syncedHeaderNumber from target chain", "root", rootChain, "target", targetChain, "error", err)
+		return
+	}
+	nextSyncCheckpointNumber := lastSyncedCheckpointNumber + 1
+	// fetch nextHeaderBlock from rootChain
+	start, end, proposer, err := cp.getHeaderBlock(checkpointContext, nextSyncCheckpointNumber, rootChain)
+	if err != nil {
+		cp.Logger.Error("Error fetching currentHeaderBlock from rootChain", "root", rootChain, "error", err)
+		return
+	}
+
+	latestCheckpointInfo, err := cp.getCurrentCheckpoint(checkpointContext, rootChain)
+	if err != nil {
+		cp.Logger.Error("Error get latest checkpoint", "root", rootChain)
+		return
+	}
 
-		cp.Logger.Info("checkpoint sync is ready to send", "root", rootChain, "checkpointSyncNumber", nextSyncCheckpointNumber, "start", start, "end", end,
-			"latestCheckpointNumber", latestCheckpointInfo.number)
-		if end != 0 {
-			// send checkpoint sync
-			msg := checkpointTypes.NewMsgCheckpointSync(hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				proposer, nextSyncCheckpointNumber, start, end, rootChain)
-			// return broadcast to heimdall
-			if isCurrentValidator, delay := util.CalculateTaskDelay(cp.cliCtx); isCurrentValidator {
-				if err := cp.txBroadcaster.BroadcastToHeimdallWithDelay(msg, delay); err != nil {
-					cp.Logger.Error("Error while broadcasting checkpoint-sync to heimdall",
-						"root", rootChain, "number", nextSyncCheckpointNumber, "start", start, "end", end, "error", err)
-					continue
-				}
-				cp.Logger.Info("checkpoint sync transaction sent successfully",
-					"root", rootChain, "number", nextSyncCheckpointNumber, "start", start, "end", end)
+	cp.Logger.Info("checkpoint sync is ready to send", "root", rootChain, "target", targetChain, "checkpointSyncNumber", nextSyncCheckpointNumber,
+		"start", start, "end", end, "latestCheckpointNumber", latestCheckpointInfo.number)
+	if end != 0 {
+		// send checkpoint sync
+		msg := checkpointTypes.NewMsgCheckpointSync(hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			proposer, nextSyncCheckpointNumber, start, end, rootChain, rootChainID, targetChain)
+		// return broadcast to heimdall
+		if isCurrentValidator, delay := util.CalculateTaskDelay(cp.cliCtx); isCurrentValidator {
+			if err := cp.txBroadcaster.BroadcastToHeimdallWithDelay(msg, delay); err != nil {
+				cp.Logger.Error("Error while broadcasting checkpoint-sync to heimdall",
+					"root", rootChain, "target", targetChain, "number", nextSyncCheckpointNumber, "start", start, "end", end, "error", err)
+				return
 			}
+			cp.Logger.Info("checkpoint sync transaction sent successfully",
+				"root", rootChain, "target", targetChain, "number", nextSyncCheckpointNumber, "start", start, "end", end)
 		}
 	}
 }
@@ -109,6 +138,7 @@ func (cp *CheckpointProcessor) sendCheckpointSyncToStakeChain(eventBytes string,
 		startBlock, endBlock, number uint64
 		txHash                       string
 		rootChain                    string
+		targetChain                  = hmTypes.RootChainTypeStake
 	)
 
 	for _, attr := range event.Attributes {
@@ -124,21 +154,25 @@ func (cp *CheckpointProcessor) sendCheckpointSyncToStakeChain(eventBytes string,
 		if attr.Key == checkpointTypes.AttributeKeyRootChain {
 			rootChain = attr.Value
 		}
+		if attr.Key == checkpointTypes.AttributeKeyTargetChain {
+			targetChain = attr.Value
+		}
 		if attr.Key == checkpointTypes.AttributeKeyHeaderIndex {
 			number, _ = strconv.ParseUint(attr.Value, 10, 64)
 		}
 	}
-	cp.Logger.Info("Received sendCheckpointSyncToStakeChain request", "number", number, "root", rootChain)
+	cp.Logger.Info("Received sendCheckpointSyncToStakeChain request", "number", number, "root", rootChain, "target", targetChain)
 
-	checkpointContext, err := cp.getCheckpointContext(rootChain)
+	rootChainIDs, err := util.GetRootChainIDMap(cp.cliCtx)
 	if err != nil {
+		cp.Logger.Error("Error while fetching root chain ids", "error", err)
 		return err
 	}
 
-	// fetch latest syncHeaderBlock from stakeChain
-	lastSyncedHeaderNumber, err := cp.getLastSyncedCheckpointNumber(checkpointContext, rootChain)
+	// fetch latest syncHeaderBlock from target chain
+	lastSyncedHeaderNumber, err := cp.getLastSyncedCheckpointNumber(rootChainIDs[rootChain], targetChain)
 	if err != nil {
-		cp.Logger.Error("Error fetching syncedHeaderNumber from stakeChain", "root", rootChain, "error", err)
+		cp.Logger.Error("Error fetching syncedHeaderNumber from target chain", "root", rootChain, "target", targetChain, "error", err)
 		return err
 	}
 	if number != lastSyncedHeaderNumber+1 {
@@ -146,24 +180,17 @@ func (cp *CheckpointProcessor) sendCheckpointSyncToStakeChain(eventBytes string,
 			"number", number, "lastSynced", lastSyncedHeaderNumber)
 	} else {
 		txHash := common.FromHex(txHash)
-		if err := cp.createAndSendCheckpointSyncToTron(checkpointContext, number, startBlock, endBlock, rootChain, blockHeight, txHash); err != nil {
-			cp.Logger.Error("Error sending checkpoint to rootchain", "error", err)
+		if err := cp.createAndSendCheckpointSync(number, startBlock, endBlock, rootChain, targetChain, blockHeight, txHash); err != nil {
+			cp.Logger.Error("Error sending checkpoint sync to target chain", "target", targetChain, "error", err)
 			return err
 		}
 	}
 	return nil
 }
 
-// sendCheckpointSyncAckToHeimdall - handles checkpointSyncAck event from stake chain
+// sendCheckpointSyncAckToHeimdall - handles checkpointSyncAck event from target chain
 // 1. create and broadcast checkpointSyncAck msg to heimdall.
-func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string, checkpointSyncAckStr string, rootChain string) error {
-	// fetch fresh checkpoint context
-	checkpointContext, err := cp.getCheckpointContext(rootChain)
-	if err != nil {
-		return err
-	}
-	checkpointParams := checkpointContext.CheckpointParams
-
+func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string, checkpointSyncAckStr string, targetChain string) error {
 	var log = types.Log{}
 	if err := json.Unmarshal([]byte(checkpointSyncAckStr), &log); err != nil {
 		cp.Logger.Error("Error while unmarshalling event from stake chain", "error", err)
@@ -180,7 +207,7 @@ func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string,
 	if err := helper.UnpackLog(cp.stakingInfoAbi, event, eventName, &log); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		checkpointChain := hmTypes.GetRootChainName(event.ChainId.Uint64())
+		checkpointChain := util.GetRootChainName(cp.cliCtx, event.ChainId.Uint64())
 
 		cp.Logger.Info(
 			"✅ Received task to send checkpoint-sync-ack to heimdall",
@@ -188,11 +215,20 @@ func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string,
 			"start", event.Start,
 			"end", event.End,
 			"root", checkpointChain,
+			"target", targetChain,
 			"proposer", event.Proposer.Hex(),
 			"checkpointNumber", event.CheckpointId,
 		)
+
+		// fetch fresh checkpoint context
+		checkpointContext, err := cp.getCheckpointContext(checkpointChain)
+		if err != nil {
+			return err
+		}
+		checkpointParams := checkpointContext.ChainCheckpointParams(checkpointChain)
+
 		// fetch checkpoint sync buffer
-		bufferedCheckpoint, err := util.GetBufferedCheckpointSync(cp.cliCtx, checkpointChain)
+		bufferedCheckpoint, err := util.GetBufferedCheckpointSync(cp.cliCtx, checkpointChain, targetChain)
 
 		if err != nil {
 			cp.Logger.Debug("checkpoint sync buffer has been cleared,  this ack already submitted.")
@@ -213,6 +249,7 @@ func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string,
 			event.Start.Uint64(),
 			event.End.Uint64(),
 			checkpointChain,
+			targetChain,
 		)
 
 		// return broadcast to heimdall
@@ -226,51 +263,58 @@ func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string,
 
 // getHeaderBlock - get header block info from rootchain
 func (cp *CheckpointProcessor) getHeaderBlock(checkpointContext *CheckpointContext, headerNumber uint64, rootChain string) (start, end uint64, proposer hmTypes.HeimdallAddress, err error) {
-	// get chain params
-	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	contracts, err := util.GetRootChainContracts(cp.cliCtx, rootChain)
+	if err != nil {
+		return 0, 0, hmTypes.ZeroHeimdallAddress, err
+	}
+	checkpointParams := checkpointContext.ChainCheckpointParams(rootChain)
 
-	switch rootChain {
-	case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc:
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chainParams.RootChainAddress.EthAddress(), rootChain)
-		if err != nil {
-			return 0, 0, hmTypes.ZeroHeimdallAddress, err
-		}
-		_, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(headerNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
-		if err != nil {
-			cp.Logger.Error("Error while fetching header block", "root", rootChain, "error", err)
-			return 0, 0, hmTypes.ZeroHeimdallAddress, err
-		}
-		return start, end, proposer, nil
-	case hmTypes.RootChainTypeTron:
-		_, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(headerNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	adapter, err := helper.GetRootChainAdapter(&cp.contractConnector, rootChain)
+	if err != nil {
+		return 0, 0, hmTypes.ZeroHeimdallAddress, err
+	}
 
-		if err != nil {
-			cp.Logger.Error("Error while fetching header block", "root", rootChain, "error", err)
-			return 0, 0, hmTypes.ZeroHeimdallAddress, err
-		}
-		return start, end, proposer, nil
+	_, start, end, _, proposer, err = adapter.GetHeaderInfo(headerNumber, contracts, checkpointParams.ChildBlockInterval)
+	if err != nil {
+		cp.Logger.Error("Error while fetching header block", "root", rootChain, "error", err)
+		return 0, 0, hmTypes.ZeroHeimdallAddress, err
 	}
-	return 0, 0, hmTypes.ZeroHeimdallAddress, nil
+
+	return start, end, proposer, nil
+}
+
+// getSyncTargetContracts - get contracts of staking chain checkpoints are synced to
+func (cp *CheckpointProcessor) getSyncTargetContracts(targetChain string) (helper.RootChainContracts, error) {
+	return util.GetRootChainContracts(cp.cliCtx, targetChain)
 }
 
-// getLastSyncedCheckpointNumber - get last checkpoint header number from stake chain
-func (cp *CheckpointProcessor) getLastSyncedCheckpointNumber(checkpointContext *CheckpointContext, rootChain string) (uint64, error) {
-	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	syncedHeaderNumber, err := cp.contractConnector.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress, rootChain)
+// getLastSyncedCheckpointNumber - get last checkpoint header number of root chain from target chain
+func (cp *CheckpointProcessor) getLastSyncedCheckpointNumber(rootChainID byte, targetChain string) (uint64, error) {
+	contracts, err := cp.getSyncTargetContracts(targetChain)
+	if err != nil {
+		cp.Logger.Error("Error while fetching target chain contracts", "target", targetChain, "error", err)
+		return 0, err
+	}
+
+	adapter, err := helper.GetRootChainAdapter(&cp.contractConnector, targetChain)
+	if err != nil {
+		return 0, err
+	}
+
+	syncedHeaderNumber, err := adapter.GetSyncedCheckpointID(rootChainID, contracts)
 	if err != nil {
-		cp.Logger.Error("Error while fetching current synced header block number from stake chain", "error", err)
+		cp.Logger.Error("Error while fetching current synced header block number from target chain", "target", targetChain, "error", err)
 		return 0, err
 	}
 	return syncedHeaderNumber, nil
 }
 
-// createAndSendCheckpointSyncToTron prepares the data required for checkpoint sync submission
-// and sends a transaction to tron
-func (cp *CheckpointProcessor) createAndSendCheckpointSyncToTron(
-	checkpointContext *CheckpointContext, number, start, end uint64, rootChain string, height int64, txHash []byte) error {
-	cp.Logger.Info("Preparing checkpoint sync to be pushed on tron",
-		"height", height, "txHash", hmTypes.BytesToHeimdallHash(txHash), "number", number, "root", rootChain, "start", start, "end", end)
+// createAndSendCheckpointSync prepares the data required for checkpoint sync submission
+// and sends a transaction to target chain
+func (cp *CheckpointProcessor) createAndSendCheckpointSync(
+	number, start, end uint64, rootChain string, targetChain string, height int64, txHash []byte) error {
+	cp.Logger.Info("Preparing checkpoint sync to be pushed on target chain", "height", height, "txHash", hmTypes.BytesToHeimdallHash(txHash),
+		"number", number, "root", rootChain, "target", targetChain, "start", start, "end", end)
 	// proof
 	tx, err := helper.QueryTxWithProof(cp.cliCtx, txHash)
 	if err != nil {
@@ -303,14 +347,24 @@ func (cp *CheckpointProcessor) createAndSendCheckpointSyncToTron(
 		return err
 	}
 
+	adapter, err := helper.GetRootChainAdapter(&cp.contractConnector, targetChain)
+	if err != nil {
+		cp.Logger.Error("Error while fetching root chain adapter", "target", targetChain, "error", err)
+		return err
+	}
+
 	// chain manager params
-	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	err = cp.contractConnector.SendCheckpointSyncToTron(sideTxData, sigs, chainParams.TronStakingManagerAddress)
+	contracts, err := cp.getSyncTargetContracts(targetChain)
 	if err != nil {
-		cp.Logger.Error("Error submitting checkpoint sync to tron", "error", err)
+		cp.Logger.Error("Error while fetching target chain contracts", "target", targetChain, "error", err)
+		return err
+	}
+
+	if err := adapter.SendCheckpointSync(sideTxData, sigs, contracts); err != nil {
+		cp.Logger.Error("Error submitting checkpoint sync to target chain", "target", targetChain, "error", err)
 		return err
 	}
-	cp.Logger.Info("Submitted new checkpoint sync to tron successfully")
+	cp.Logger.Info("Submitted new checkpoint sync to target chain successfully", "target", targetChain)
 
 	return nil
 }
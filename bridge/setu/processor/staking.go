@@ -90,6 +90,12 @@ func (sp *StakingProcessor) RegisterTasks() {
 	if err := sp.queueConnector.Server.RegisterTask("sendStakingAckToHeimdall", sp.sendStakingAckToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakingAckToHeimdall", "error", err)
 	}
+	if err := sp.queueConnector.Server.RegisterTask("sendShareMintedToHeimdall", sp.sendShareMintedToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendShareMintedToHeimdall", "error", err)
+	}
+	if err := sp.queueConnector.Server.RegisterTask("sendShareBurnedToHeimdall", sp.sendShareBurnedToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendShareBurnedToHeimdall", "error", err)
+	}
 }
 
 func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logBytes string, rootChain string) error {
@@ -388,6 +394,132 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 	return nil
 }
 
+func (sp *StakingProcessor) sendShareMintedToHeimdall(eventName string, logBytes string, rootChain string) error {
+	if rootChain != hmTypes.RootChainTypeStake {
+		sp.Logger.Error("There should be no messages from un-stake.", "root", rootChain)
+		return nil
+	}
+
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoShareMinted)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+	} else {
+		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+			sp.Logger.Info("Ignoring task to send share-minted to heimdall as already processed",
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"delegator", event.User.Hex(),
+				"shares", event.Amount,
+				"tokens", event.Tokens,
+				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		}
+
+		sp.Logger.Info(
+			"✅ Received task to send share-minted to heimdall",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"delegator", event.User.Hex(),
+			"shares", event.Amount,
+			"tokens", event.Tokens,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		// msg delegate
+		msg := stakingTypes.NewMsgDelegate(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+			sdk.NewIntFromBigInt(event.Amount),
+			sdk.NewIntFromBigInt(event.Tokens),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// return broadcast to heimdall
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting delegate to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (sp *StakingProcessor) sendShareBurnedToHeimdall(eventName string, logBytes string, rootChain string) error {
+	if rootChain != hmTypes.RootChainTypeStake {
+		sp.Logger.Error("There should be no messages from un-stake.", "root", rootChain)
+		return nil
+	}
+
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		sp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	event := new(stakinginfo.StakinginfoShareBurned)
+	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+	} else {
+		if isOld, _ := sp.isOldTx(sp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+			sp.Logger.Info("Ignoring task to send share-burned to heimdall as already processed",
+				"event", eventName,
+				"validatorID", event.ValidatorId,
+				"delegator", event.User.Hex(),
+				"shares", event.Amount,
+				"tokens", event.Tokens,
+				"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				"logIndex", uint64(vLog.Index),
+				"blockNumber", vLog.BlockNumber,
+			)
+			return nil
+		}
+
+		sp.Logger.Info(
+			"✅ Received task to send share-burned to heimdall",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"delegator", event.User.Hex(),
+			"shares", event.Amount,
+			"tokens", event.Tokens,
+			"txHash", hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+
+		// msg undelegate
+		msg := stakingTypes.NewMsgUndelegate(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.ValidatorId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+			sdk.NewIntFromBigInt(event.Amount),
+			sdk.NewIntFromBigInt(event.Tokens),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// return broadcast to heimdall
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting undelegate to heimdall", "validatorId", event.ValidatorId.Uint64(), "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
 // isOldTx  checks if tx is already processed or not
 func (sp *StakingProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
 	queryParam := map[string]interface{}{
@@ -449,8 +581,15 @@ func (sp *StakingProcessor) checkStakingSyncAck() {
 		return
 	}
 
-	for rootChain := range hmTypes.GetRootChainIDMap() {
-		if rootChain == hmTypes.RootChainTypeStake {
+	rootChainIDs, err := util.GetRootChainIDMap(sp.cliCtx)
+	if err != nil {
+		sp.Logger.Error("Error while fetching root chain ids", "error", err)
+		return
+	}
+
+	for rootChain := range rootChainIDs {
+		// records of paused chain stay queued until chain is active again
+		if rootChain == hmTypes.RootChainTypeStake || !util.IsChainActive(sp.cliCtx, rootChain) {
 			continue
 		}
 		// fetch fresh staking context
@@ -488,6 +627,12 @@ func (sp *StakingProcessor) checkStakingSyncAck() {
 // 2. check if should send.
 // 3. Send staking sync to root if required.
 func (sp *StakingProcessor) checkAndSendStakingSync(rootChain string, toRootChain bool) {
+	// paused or retired chain doesn't receive staking sync
+	if !util.IsChainActive(sp.cliCtx, rootChain) {
+		sp.Logger.Debug("Root chain is paused or retired", "root", rootChain)
+		return
+	}
+
 	// fetch fresh staking context
 	stakingContext, err := sp.getStakingContext(rootChain)
 	if err != nil {
@@ -516,16 +661,17 @@ func (sp *StakingProcessor) checkAndSendStakingSync(rootChain string, toRootChai
 }
 
 func (sp *StakingProcessor) getNonceFromRootChain(stakingContext *StakingContext, rootChain string, validatorID uint64) uint64 {
-	switch rootChain {
-	case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc:
-		stakingManagerAddress := stakingContext.ChainmanagerParams.ChainParams.StakingManagerAddress.EthAddress()
-		stakingManagerInstance, _ := sp.contractConnector.GetStakeManagerInstance(stakingManagerAddress, rootChain)
-		return sp.contractConnector.GetMainStakingSyncNonce(validatorID, stakingManagerInstance)
-	case hmTypes.RootChainTypeTron:
-		stakingManagerAddress := stakingContext.ChainmanagerParams.ChainParams.TronStakingManagerAddress
-		return sp.contractConnector.GetTronStakingSyncNonce(validatorID, stakingManagerAddress)
-	}
-	return 0
+	adapter, err := helper.GetRootChainAdapter(&sp.contractConnector, rootChain)
+	if err != nil {
+		sp.Logger.Error("Error while fetching root chain adapter", "root", rootChain, "error", err)
+		return 0
+	}
+	contracts, err := util.GetRootChainContracts(sp.cliCtx, rootChain)
+	if err != nil {
+		sp.Logger.Error("Error while fetching root chain contracts", "root", rootChain, "error", err)
+		return 0
+	}
+	return adapter.GetStakingSyncNonce(validatorID, contracts)
 }
 
 func (sp *StakingProcessor) shouldSendStakingSync(stakingContext *StakingContext, rootChain string) (*stakingTypes.StakingRecord, bool) {
@@ -577,17 +723,18 @@ func (sp *StakingProcessor) createAndSendStakingSyncToRootChain(stakingContext *
 		sp.Logger.Error("Error fetching votes for staking record tx", "height", stakingInfo.Height, "error", err)
 		return
 	}
-	// chain manager params
-	chainParams := stakingContext.ChainmanagerParams.ChainParams
-	stakingManagerAddress := chainParams.StakingManagerAddress.EthAddress()
-	// staking manager instance
-	stakingManagerInstance, err := sp.contractConnector.GetStakeManagerInstance(stakingManagerAddress, rootChain)
+	adapter, err := helper.GetRootChainAdapter(&sp.contractConnector, rootChain)
 	if err != nil {
-		sp.Logger.Error("Error while creating staking instance", "error", err)
+		sp.Logger.Error("Error while fetching root chain adapter", "root", rootChain, "error", err)
 		return
 	}
-	if err := sp.contractConnector.SendMainStakingSync(stakingInfo.Type, sideTxData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain); err != nil {
-		sp.Logger.Error("Error submitting staking sync to rootchain", "error", err)
+	contracts, err := util.GetRootChainContracts(sp.cliCtx, rootChain)
+	if err != nil {
+		sp.Logger.Error("Error while fetching root chain contracts", "root", rootChain, "error", err)
+		return
+	}
+	if err := adapter.SendStakingSync(stakingInfo.Type, sideTxData, sigs, contracts); err != nil {
+		sp.Logger.Error("Error submitting staking sync to rootchain", "root", rootChain, "error", err)
 		return
 	}
 }
@@ -609,7 +756,13 @@ func (sp *StakingProcessor) sendStakingSyncToHeimdall(eventBytes string, blockHe
 		return nil
 	}
 
-	for rootChain := range hmTypes.GetRootChainIDMap() {
+	rootChainIDs, err := util.GetRootChainIDMap(sp.cliCtx)
+	if err != nil {
+		sp.Logger.Error("Error while fetching root chain ids", "error", err)
+		return err
+	}
+
+	for rootChain := range rootChainIDs {
 		if rootChain == hmTypes.RootChainTypeStake {
 			continue
 		}
@@ -26,16 +26,18 @@ func (cp *CheckpointProcessor) sendTronCheckpointToHeimdall(checkpointContext *C
 	//
 	// Check checkpoint buffer
 	//
-	timeStamp := uint64(time.Now().Unix())
-	checkpointBufferTime := uint64(checkpointContext.CheckpointParams.CheckpointBufferTime.Seconds())
+	lastPending, full := cp.checkPendingCheckpoints(checkpointContext, hmTypes.RootChainTypeTron)
+	if full {
+		cp.Logger.Info("Checkpoint[tron] already exists in buffer", "Checkpoint", lastPending.String())
+		return
+	}
 
-	bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx, hmTypes.RootChainTypeTron)
-	if err != nil {
-		cp.Logger.Debug("No buffered checkpoint[tron]", "bufferedCheckpoint", bufferedCheckpoint)
+	if lastPending != nil {
+		// queue next checkpoint behind the ones waiting for ack
+		start, end = cp.nextPipelinedCheckpoint(checkpointContext, *lastPending, latestConfirmedChildBlock, hmTypes.RootChainTypeTron)
 	}
 
-	if bufferedCheckpoint != nil && !(bufferedCheckpoint.TimeStamp == 0 || ((timeStamp > bufferedCheckpoint.TimeStamp) && timeStamp-bufferedCheckpoint.TimeStamp >= checkpointBufferTime)) {
-		cp.Logger.Info("Checkpoint[tron] already exists in buffer", "Checkpoint", bufferedCheckpoint.String())
+	if cp.deferCheckpoint(start, end, hmTypes.RootChainTypeTron) {
 		return
 	}
 
@@ -48,7 +50,7 @@ func (cp *CheckpointProcessor) sendTronCheckpointToHeimdall(checkpointContext *C
 // nextExpectedTronCheckpoint - fetched contract checkpoint state and returns the next probable checkpoint that needs to be sent
 func (cp *CheckpointProcessor) nextExpectedTronCheckpoint(checkpointContext *CheckpointContext, latestChildBlock uint64) (*ContractCheckpoint, error) {
 	chainManagerParams := checkpointContext.ChainmanagerParams
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(hmTypes.RootChainTypeTron)
 
 	// fetch current header block from tron contract
 	_currentHeaderBlock, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainManagerParams.ChainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
@@ -135,6 +137,10 @@ func (cp *CheckpointProcessor) createAndSendTronCheckpointToHeimdall(checkpointC
 	}
 	// fetch latest checkpoint
 	latestCheckpoint, err := util.GetlastestCheckpoint(cp.cliCtx, hmTypes.RootChainTypeTron)
+	// checkpoints waiting for ack come after latest checkpoint
+	if pending, pendingErr := util.GetPendingCheckpoints(cp.cliCtx, hmTypes.RootChainTypeTron); pendingErr == nil && len(pending) != 0 {
+		latestCheckpoint, err = &pending[len(pending)-1], nil
+	}
 	// event checkpoint is older than or equal to latest checkpoint
 	if err == nil && latestCheckpoint != nil && latestCheckpoint.EndBlock+1 < start {
 		cp.Logger.Debug("Need to resubmit Checkpoint ack first", "start", start, "last_end", latestCheckpoint.EndBlock)
@@ -146,7 +152,7 @@ func (cp *CheckpointProcessor) createAndSendTronCheckpointToHeimdall(checkpointC
 		return nil
 	}
 	// get checkpoint params
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(hmTypes.RootChainTypeTron)
 
 	// Get root hash
 	root, err := cp.contractConnector.GetRootHash(start, end, checkpointParams.MaxCheckpointLength)
@@ -268,10 +274,17 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToTron(checkpointContext *
 	}
 
 	if shouldSend {
-		// chain manager params
-		chainParams := checkpointContext.ChainmanagerParams.ChainParams
-		err := cp.contractConnector.SendTronCheckpoint(sideTxData, sigs, chainParams.TronChainAddress)
+		adapter, err := helper.GetRootChainAdapter(&cp.contractConnector, hmTypes.RootChainTypeTron)
 		if err != nil {
+			cp.Logger.Error("Error while fetching root chain adapter", "root", hmTypes.RootChainTypeTron, "error", err)
+			return err
+		}
+		contracts, err := util.GetRootChainContracts(cp.cliCtx, hmTypes.RootChainTypeTron)
+		if err != nil {
+			cp.Logger.Error("Error while fetching root chain contracts", "root", hmTypes.RootChainTypeTron, "error", err)
+			return err
+		}
+		if err := adapter.SendCheckpoint(sideTxData, sigs, contracts); err != nil {
 			cp.Logger.Error("Error submitting checkpoint[tron] to rootchain", "error", err)
 			return err
 		}
@@ -285,7 +298,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToTron(checkpointContext *
 func (cp *CheckpointProcessor) getLatestTronCheckpointTime(checkpointContext *CheckpointContext) (int64, error) {
 	// get chain params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(hmTypes.RootChainTypeTron)
 
 	// fetch last header number
 	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
@@ -307,7 +320,7 @@ func (cp *CheckpointProcessor) getLatestTronCheckpointTime(checkpointContext *Ch
 func (cp *CheckpointProcessor) resubmitTronCheckpointAck(checkpointContext *CheckpointContext) error {
 	// get chain params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	checkpointParams := checkpointContext.CheckpointParams
+	checkpointParams := checkpointContext.ChainCheckpointParams(hmTypes.RootChainTypeTron)
 
 	// fetch last header number
 	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
@@ -0,0 +1,128 @@
+package util
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// CheckpointCostStatus cost-aware checkpoint policy state of root chain
+type CheckpointCostStatus struct {
+	RootChain         string        `json:"root_chain"`
+	Enabled           bool          `json:"enabled"`
+	Cost              string        `json:"cost"`
+	MaxCost           string        `json:"max_cost"`
+	CostPercent       uint64        `json:"cost_percent"`
+	Threshold         uint64        `json:"threshold"`
+	LastCheckpointAge time.Duration `json:"last_checkpoint_age"`
+	MaxDelay          time.Duration `json:"max_delay"`
+	Deferring         bool          `json:"deferring"`
+	Error             string        `json:"error,omitempty"`
+}
+
+// GetCheckpointCostPolicy returns configured policy switch, threshold and max delay
+func GetCheckpointCostPolicy() (bool, uint64, time.Duration) {
+	config := helper.GetConfig()
+
+	threshold := config.CheckpointCostThreshold
+	if threshold == 0 {
+		threshold = helper.DefaultCheckpointCostThreshold
+	}
+
+	maxDelay := config.CheckpointCostMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = helper.DefaultCheckpointCostMaxDelay
+	}
+
+	return config.CheckpointCostPolicy, threshold, maxDelay
+}
+
+// NewCheckpointCostStatus computes whether checkpoint should be deferred for given cost and last checkpoint age
+func NewCheckpointCostStatus(rootChain string, enabled bool, cost, maxCost *big.Int, threshold uint64, age, maxDelay time.Duration) CheckpointCostStatus {
+	status := CheckpointCostStatus{
+		RootChain:         rootChain,
+		Enabled:           enabled,
+		Cost:              cost.String(),
+		MaxCost:           maxCost.String(),
+		Threshold:         threshold,
+		LastCheckpointAge: age,
+		MaxDelay:          maxDelay,
+	}
+
+	if maxCost.Sign() > 0 {
+		percent := new(big.Int).Mul(cost, big.NewInt(100))
+		percent.Div(percent, maxCost)
+
+		if percent.IsUint64() {
+			status.CostPercent = percent.Uint64()
+		} else {
+			status.CostPercent = ^uint64(0)
+		}
+	}
+
+	status.Deferring = enabled && status.CostPercent > threshold && age < maxDelay
+
+	return status
+}
+
+// GetCheckpointCostStatus returns cost-aware checkpoint policy state of root chain
+func GetCheckpointCostStatus(cliCtx cliContext.CLIContext, caller helper.IContractCaller, rootChain string) CheckpointCostStatus {
+	enabled, threshold, maxDelay := GetCheckpointCostPolicy()
+
+	status := CheckpointCostStatus{
+		RootChain: rootChain,
+		Enabled:   enabled,
+		Threshold: threshold,
+		MaxDelay:  maxDelay,
+	}
+
+	adapter, err := helper.GetRootChainAdapter(caller, rootChain)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	cost, maxCost, err := adapter.GetCheckpointCost()
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	// no checkpoint yet, nothing to defer
+	age := maxDelay
+
+	lastCheckpoint, err := GetlastestCheckpoint(cliCtx, rootChain)
+	if err == nil && lastCheckpoint != nil && lastCheckpoint.TimeStamp != 0 {
+		age = time.Since(time.Unix(int64(lastCheckpoint.TimeStamp), 0))
+	}
+
+	return NewCheckpointCostStatus(rootChain, enabled, cost, maxCost, threshold, age, maxDelay)
+}
+
+// GetCheckpointCostStatuses returns cost-aware checkpoint policy state of every registered root chain
+func GetCheckpointCostStatuses(cliCtx cliContext.CLIContext, caller helper.IContractCaller) ([]CheckpointCostStatus, error) {
+	chainIDs, err := GetRootChainIDMap(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	rootChains := make([]string, 0, len(chainIDs))
+	for rootChain := range chainIDs {
+		rootChains = append(rootChains, rootChain)
+	}
+
+	sort.Slice(rootChains, func(i, j int) bool {
+		return chainIDs[rootChains[i]] < chainIDs[rootChains[j]]
+	})
+
+	statuses := make([]CheckpointCostStatus, 0, len(rootChains))
+	for _, rootChain := range rootChains {
+		statuses = append(statuses, GetCheckpointCostStatus(cliCtx, caller, rootChain))
+	}
+
+	return statuses, nil
+}
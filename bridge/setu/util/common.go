@@ -43,10 +43,16 @@ const (
 	ChainManagerParamsURL   = "/chainmanager/params"
 	// for new eth forkChain such as bsc, replace address of params.
 	ChainNewParamsURL         = "/chainmanager/newparams/%v"
+	RootChainIDsURL           = "/chainmanager/root-chain-ids"
+	NextRootChainIDURL        = "/chainmanager/next-root-chain-id"
+	ChainStatusURL            = "/chainmanager/chain-status/%v"
+	RootChainContractsURL     = "/chainmanager/root-chain-contracts/%v"
 	TaragetFeatureConfigURL   = "/featuremanager/target-feature/%v"
 	AllFeatureConfigURL       = "/featuremanager/feature-map"
 	ProposersURL              = "/staking/proposer/%v"
 	BufferedCheckpointURL     = "/checkpoints/buffer/%v"
+	PendingCheckpointsURL     = "/checkpoints/pending/%v"
+	CheckpointRelayURL        = "/checkpoints/relay/%v"
 	BufferedCheckpointSyncURL = "/checkpoints/sync/%v"
 	LatestCheckpointURL       = "/checkpoints/latest/%v"
 	CurrentProposerURL        = "/staking/current-proposer"
@@ -406,6 +412,119 @@ func GetNewChainParams(cliCtx cliContext.CLIContext, rootChain string) (*chainMa
 	return &params, nil
 }
 
+// GetRootChainIDMap return root chain ids registered in chain manager
+func GetRootChainIDMap(cliCtx cliContext.CLIContext) (map[string]byte, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(RootChainIDsURL),
+	)
+	if err != nil {
+		logger.Error("Error fetching root chain ids", "err", err)
+		return nil, err
+	}
+
+	var rootChainIDs []chainManagerTypes.RootChainID
+	if err := json.Unmarshal(response.Result, &rootChainIDs); err != nil {
+		logger.Error("Error unmarshalling root chain ids", "url", RootChainIDsURL, "err", err)
+		return nil, err
+	}
+
+	chainIDs := make(map[string]byte, len(rootChainIDs))
+	for _, rootChainID := range rootChainIDs {
+		chainIDs[rootChainID.RootChainType] = rootChainID.ChainID
+	}
+
+	return chainIDs, nil
+}
+
+// GetNextRootChainID return id chain manager allocates to next new root chain
+func GetNextRootChainID(cliCtx cliContext.CLIContext) (byte, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(NextRootChainIDURL),
+	)
+	if err != nil {
+		logger.Error("Error fetching next root chain id", "err", err)
+		return 0, err
+	}
+
+	var rootChainID byte
+	if err := json.Unmarshal(response.Result, &rootChainID); err != nil {
+		logger.Error("Error unmarshalling next root chain id", "url", NextRootChainIDURL, "err", err)
+		return 0, err
+	}
+
+	return rootChainID, nil
+}
+
+// GetRootChainName return name of root chain registered in chain manager with id
+func GetRootChainName(cliCtx cliContext.CLIContext, rootChainID uint64) string {
+	rootChainIDs, err := GetRootChainIDMap(cliCtx)
+	if err != nil {
+		return "no-chain"
+	}
+
+	for rootChain, chainID := range rootChainIDs {
+		if uint64(chainID) == rootChainID {
+			return rootChain
+		}
+	}
+
+	return "no-chain"
+}
+
+// GetChainStatus return status of root chain
+func GetChainStatus(cliCtx cliContext.CLIContext, rootChain string) (*chainManagerTypes.ChainStatusResponse, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(ChainStatusURL, rootChain)),
+	)
+	if err != nil {
+		logger.Error("Error fetching chain status", "root", rootChain, "err", err)
+		return nil, err
+	}
+
+	var chainStatus chainManagerTypes.ChainStatusResponse
+	if err := json.Unmarshal(response.Result, &chainStatus); err != nil {
+		logger.Error("Error unmarshalling chain status", "root", rootChain, "url", ChainStatusURL, "err", err)
+		return nil, err
+	}
+
+	return &chainStatus, nil
+}
+
+// GetRootChainContracts return contract addresses of root chain registered in chain manager
+func GetRootChainContracts(cliCtx cliContext.CLIContext, rootChain string) (helper.RootChainContracts, error) {
+	var contracts helper.RootChainContracts
+
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(RootChainContractsURL, rootChain)),
+	)
+	if err != nil {
+		logger.Error("Error fetching root chain contracts", "root", rootChain, "err", err)
+		return contracts, err
+	}
+
+	if err := json.Unmarshal(response.Result, &contracts); err != nil {
+		logger.Error("Error unmarshalling root chain contracts", "root", rootChain, "url", RootChainContractsURL, "err", err)
+		return contracts, err
+	}
+
+	return contracts, nil
+}
+
+// IsChainActive returns false if root chain is paused or retired
+func IsChainActive(cliCtx cliContext.CLIContext, rootChain string) bool {
+	chainStatus, err := GetChainStatus(cliCtx, rootChain)
+	if err != nil {
+		// heimdall rejects traffic for inactive chains anyway
+		return true
+	}
+
+	return chainStatus.Active
+}
+
 // GetCheckpointParams return params
 func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params, error) {
 	response, err := helper.FetchFromAPI(
@@ -446,20 +565,62 @@ func GetBufferedCheckpoint(cliCtx cliContext.CLIContext, rootChain string) (*hmt
 	return &checkpoint, nil
 }
 
-// GetBufferedCheckpointSync return checkpoint sync from buffer
-func GetBufferedCheckpointSync(cliCtx cliContext.CLIContext, rootChain string) (*hmtypes.Checkpoint, error) {
+// GetPendingCheckpoints return checkpoints waiting for ack, buffered checkpoint first
+func GetPendingCheckpoints(cliCtx cliContext.CLIContext, rootChain string) ([]hmtypes.Checkpoint, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(PendingCheckpointsURL, rootChain)),
+	)
+	if err != nil {
+		logger.Debug("Error fetching pending checkpoints", "root", rootChain, "err", err)
+		return nil, err
+	}
+
+	var checkpoints []hmtypes.Checkpoint
+	if err := json.Unmarshal(response.Result, &checkpoints); err != nil {
+		logger.Error("Error unmarshalling pending checkpoints", "url", PendingCheckpointsURL, "err", err)
+		return nil, err
+	}
+
+	return checkpoints, nil
+}
+
+// GetCheckpointRelayPayload return signed payload of checkpoint waiting for ack, buffered checkpoint if number is zero
+func GetCheckpointRelayPayload(cliCtx cliContext.CLIContext, rootChain string, number uint64) (*checkpointTypes.CheckpointRelayPayload, error) {
+	endpoint := helper.GetHeimdallServerEndpoint(fmt.Sprintf(CheckpointRelayURL, rootChain))
+	if number != 0 {
+		endpoint = helper.GetHeimdallServerEndpointWithQuery(fmt.Sprintf(CheckpointRelayURL, rootChain), fmt.Sprintf("number=%d", number))
+	}
+
+	response, err := helper.FetchFromAPI(cliCtx, endpoint)
+	if err != nil {
+		logger.Debug("Error fetching checkpoint relay payload", "root", rootChain, "number", number, "err", err)
+		return nil, err
+	}
+
+	var payload checkpointTypes.CheckpointRelayPayload
+	if err := json.Unmarshal(response.Result, &payload); err != nil {
+		logger.Error("Error unmarshalling checkpoint relay payload", "url", CheckpointRelayURL, "err", err)
+		return nil, err
+	}
+
+	return &payload, nil
+}
+
+// GetBufferedCheckpointSync return checkpoint sync of root chain to target chain from buffer
+func GetBufferedCheckpointSync(cliCtx cliContext.CLIContext, rootChain string, targetChain string) (*hmtypes.Checkpoint, error) {
 	response, err := helper.FetchFromAPI(
 		cliCtx,
-		helper.GetHeimdallServerEndpoint(fmt.Sprintf(BufferedCheckpointSyncURL, rootChain)),
+		helper.GetHeimdallServerEndpointWithQuery(fmt.Sprintf(BufferedCheckpointSyncURL, rootChain), fmt.Sprintf("target=%s", targetChain)),
 	)
 	if err != nil {
-		logger.Debug("Error fetching buffered checkpoint sync", "root", rootChain, "err", err)
+		logger.Debug("Error fetching buffered checkpoint sync", "root", rootChain, "target", targetChain, "err", err)
 		return nil, err
 	}
 
 	var checkpoint hmtypes.Checkpoint
 	if err := json.Unmarshal(response.Result, &checkpoint); err != nil {
-		logger.Error("Error unmarshalling buffered checkpoint sync", "root", rootChain, "err", err)
+		logger.Error("Error unmarshalling buffered checkpoint sync", "root", rootChain, "target", targetChain, "err", err)
 		return nil, err
 	}
 
@@ -27,6 +27,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetQueryParams(cdc),
 			GetQueryProposalChainParam(cdc),
+			GetQueryRootChainIDs(cdc),
+			GetQueryChainStatus(cdc),
 		)...,
 	)
 	return txCmd
@@ -109,3 +111,80 @@ $ %s query chainmanager params
 		},
 	}
 }
+
+// GetQueryRootChainIDs implements the root chain ids query command.
+func GetQueryRootChainIDs(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "root-chain-ids",
+		Args:  cobra.NoArgs,
+		Short: "show the root chain ids registered in chainmanager",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query root chain ids registered in chain manager.
+Example:
+$ %s query chainmanager root-chain-ids
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRootChainIDs)
+
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var rootChainIDs types.RootChainIDs
+			if err = json.Unmarshal(bz, &rootChainIDs); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(rootChainIDs)
+		},
+	}
+}
+
+// GetQueryChainStatus implements the root chain status query command.
+func GetQueryChainStatus(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "chain-status [root-chain]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show whether root chain is active, paused or retired",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query status of root chain in chain manager.
+Example:
+$ %s query chainmanager chain-status bsc
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChainStatus)
+
+			qp, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			bz, _, err := cliCtx.QueryWithData(route, qp)
+			if err != nil {
+				return err
+			}
+
+			var chainStatus types.ChainStatusResponse
+			if err = json.Unmarshal(bz, &chainStatus); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(chainStatus)
+		},
+	}
+}
@@ -10,4 +10,8 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/chainmanager/params", paramsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/chainmanager/newparams/{root}", queryNewParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/root-chain-ids", rootChainIDsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/next-root-chain-id", nextRootChainIDHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/chain-status/{root}", chainStatusHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/root-chain-contracts/{root}", rootChainContractsHandlerFn(cliCtx)).Methods("GET")
 }
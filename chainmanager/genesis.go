@@ -9,9 +9,17 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	for _, rootChainID := range data.RootChainIDs {
+		keeper.SetRootChainID(ctx, rootChainID.RootChainType, rootChainID.ChainID)
+	}
+
 	for _, chainInfo := range data.ChainInfos {
 		keeper.AddNewChainParams(ctx, chainInfo)
 	}
+
+	for _, chainStatus := range data.ChainStatuses {
+		keeper.SetChainStatus(ctx, chainStatus)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -20,5 +28,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	return types.NewGenesisState(
 		params,
 		keeper.GetNewChainParamsList(ctx),
+		keeper.GetStoredRootChainIDs(ctx),
+		keeper.GetChainStatuses(ctx),
 	)
 }
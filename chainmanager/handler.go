@@ -18,6 +18,10 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgNewChain:
 			return HandleMsgNewChain(ctx, msg, k, contractCaller)
+		case types.MsgPauseChain:
+			return HandleMsgPauseChain(ctx, msg, k, contractCaller)
+		case types.MsgRetireChain:
+			return HandleMsgRetireChain(ctx, msg, k, contractCaller)
 
 		default:
 			return sdk.ErrTxDecode("Invalid message in staking module").Result()
@@ -30,7 +34,7 @@ func HandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, contrac
 
 	k.Logger(ctx).Debug("✅ Validating new chain msg", "msg", msg)
 
-	if hmTypes.GetRootChainID(msg.RootChainType) == 0 {
+	if msg.RootChainType == "" || msg.RootChainType == hmTypes.RootChainTypeStake {
 		k.Logger(ctx).Error("Wrong root chain type", "root", msg.RootChainType)
 		return hmCommon.ErrWrongRootChain(k.Codespace()).Result()
 	}
@@ -54,3 +58,64 @@ func HandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, contrac
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// HandleMsgPauseChain msg pause chain
+func HandleMsgPauseChain(ctx sdk.Context, msg types.MsgPauseChain, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating pause chain msg", "msg", msg)
+
+	if err := validateChainStatusChange(ctx, k, msg.RootChainType); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePauseChain,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyChainStatus, msg.Status()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatUint(msg.EffectiveHeight, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgRetireChain msg retire chain
+func HandleMsgRetireChain(ctx sdk.Context, msg types.MsgRetireChain, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating retire chain msg", "msg", msg)
+
+	if err := validateChainStatusChange(ctx, k, msg.RootChainType); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRetireChain,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatUint(msg.EffectiveHeight, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// validateChainStatusChange checks that status of root chain can be changed
+func validateChainStatusChange(ctx sdk.Context, k Keeper, rootChain string) sdk.Error {
+	// stake chain keeps validator set, it can't be paused or retired
+	if rootChain == "" || rootChain == hmTypes.RootChainTypeStake || k.GetRootChainID(ctx, rootChain) == 0 {
+		k.Logger(ctx).Error("Wrong root chain type", "root", rootChain)
+		return hmCommon.ErrWrongRootChain(k.Codespace())
+	}
+
+	if k.GetChainStatus(ctx, rootChain).IsRetired() {
+		k.Logger(ctx).Error("Root chain already retired", "root", rootChain)
+		return hmCommon.ErrChainInactive(k.Codespace())
+	}
+
+	return nil
+}
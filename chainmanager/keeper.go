@@ -2,6 +2,9 @@ package chainmanager
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +18,8 @@ import (
 
 var (
 	NewChainParamsKey = []byte{0x11} // prefix key for when storing state
+	RootChainIDKey    = []byte{0x12} // prefix key for root chain ids
+	ChainStatusKey    = []byte{0x13} // prefix key for root chain status
 )
 
 // Keeper stores all related data
@@ -42,10 +47,18 @@ func NewKeeper(
 	keeper := Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
-		paramSpace:     paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:      codespace,
 		contractCaller: caller,
 	}
+
+	// status changes by governance are checked against current chain status
+	keeper.paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable().RegisterValidator(
+		types.KeyParamsWithMultiChains,
+		func(ctx sdk.Context, value interface{}) error {
+			return keeper.validateParamsWithMultiChain(ctx, *value.(*types.ParamsWithMultiChains))
+		},
+	))
+
 	return keeper
 }
 
@@ -86,7 +99,7 @@ func (k *Keeper) GetChainParams(ctx sdk.Context, rootChain string) (types.ChainI
 
 	store := ctx.KVStore(k.storeKey)
 
-	key := append(NewChainParamsKey, hmTypes.GetRootChainID(rootChain)) //nolint:gocritic
+	key := append(NewChainParamsKey, k.GetRootChainID(ctx, rootChain)) //nolint:gocritic
 	if store.Has(key) {
 		err := k.cdc.UnmarshalBinaryBare(store.Get(key), &chainInfo)
 		if err != nil {
@@ -104,7 +117,7 @@ func (k *Keeper) GetChainParams(ctx sdk.Context, rootChain string) (types.ChainI
 
 // AddNewChainParams adds new chain into chain list
 func (k *Keeper) AddNewChainParams(ctx sdk.Context, chainInfo types.ChainInfo) error {
-	key := append(NewChainParamsKey, hmTypes.GetRootChainID(chainInfo.RootChainType))
+	key := append(NewChainParamsKey, k.GetRootChainID(ctx, chainInfo.RootChainType))
 	value, err := k.cdc.MarshalBinaryBare(chainInfo)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling chain info", "root", chainInfo.RootChainType, "error", err)
@@ -161,6 +174,276 @@ func (k *Keeper) GetChainActivationHeight(ctx sdk.Context, rootChain string) uin
 	return res
 }
 
+// -----------------------------------------------------------------------------
+// Root chain registry
+
+// GetRootChainIDKey returns key for root chain id
+func GetRootChainIDKey(rootChain string) []byte {
+	return append(RootChainIDKey, []byte(rootChain)...)
+}
+
+// GetRootChainID returns root chain id, falls back to default ids for chains known before the registry
+func (k *Keeper) GetRootChainID(ctx sdk.Context, rootChain string) byte {
+	store := ctx.KVStore(k.storeKey)
+	if value := store.Get(GetRootChainIDKey(rootChain)); len(value) == 1 {
+		return value[0]
+	}
+
+	return hmTypes.DefaultRootChainIDs[rootChain]
+}
+
+// GetRootChainName returns root chain name for id
+func (k *Keeper) GetRootChainName(ctx sdk.Context, rootChainID uint64) string {
+	for rootChain, chainID := range k.GetRootChainIDMap(ctx) {
+		if uint64(chainID) == rootChainID {
+			return rootChain
+		}
+	}
+
+	return "no-chain"
+}
+
+// GetRootChainIDMap returns all root chain ids
+func (k *Keeper) GetRootChainIDMap(ctx sdk.Context) map[string]byte {
+	chainIDs := make(map[string]byte, len(hmTypes.DefaultRootChainIDs))
+	for rootChain, chainID := range hmTypes.DefaultRootChainIDs {
+		chainIDs[rootChain] = chainID
+	}
+
+	for _, rootChainID := range k.GetStoredRootChainIDs(ctx) {
+		chainIDs[rootChainID.RootChainType] = rootChainID.ChainID
+	}
+
+	return chainIDs
+}
+
+// GetRootChainIDs returns all root chain ids sorted by id
+func (k *Keeper) GetRootChainIDs(ctx sdk.Context) []types.RootChainID {
+	chainIDs := make([]types.RootChainID, 0)
+	for rootChain, chainID := range k.GetRootChainIDMap(ctx) {
+		chainIDs = append(chainIDs, types.NewRootChainID(rootChain, chainID))
+	}
+
+	sort.Slice(chainIDs, func(i, j int) bool {
+		return chainIDs[i].ChainID < chainIDs[j].ChainID
+	})
+
+	return chainIDs
+}
+
+// GetStoredRootChainIDs returns root chain ids stored in chain registry
+func (k *Keeper) GetStoredRootChainIDs(ctx sdk.Context) []types.RootChainID {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RootChainIDKey)
+	defer iterator.Close()
+
+	var chainIDs []types.RootChainID
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Value()) == 1 {
+			chainIDs = append(chainIDs, types.NewRootChainID(string(iterator.Key()[len(RootChainIDKey):]), iterator.Value()[0]))
+		}
+	}
+
+	return chainIDs
+}
+
+// SetRootChainID stores root chain id
+func (k *Keeper) SetRootChainID(ctx sdk.Context, rootChain string, rootChainID byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetRootChainIDKey(rootChain), []byte{rootChainID})
+}
+
+// GetNextRootChainID returns next unused root chain id
+func (k *Keeper) GetNextRootChainID(ctx sdk.Context) (byte, error) {
+	var maxChainID byte
+	for _, chainID := range k.GetRootChainIDMap(ctx) {
+		if chainID > maxChainID {
+			maxChainID = chainID
+		}
+	}
+
+	if maxChainID == math.MaxUint8 {
+		return 0, errors.New("no root chain id available")
+	}
+
+	return maxChainID + 1, nil
+}
+
+// AllocateRootChainID returns id of root chain, allocates next unused id for new chain
+func (k *Keeper) AllocateRootChainID(ctx sdk.Context, rootChain string) (byte, error) {
+	if chainID := k.GetRootChainID(ctx, rootChain); chainID != 0 {
+		return chainID, nil
+	}
+
+	chainID, err := k.GetNextRootChainID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetRootChainID(ctx, rootChain, chainID)
+	k.Logger(ctx).Info("Allocated root chain id", "root", rootChain, "chainID", chainID)
+
+	return chainID, nil
+}
+
+// -----------------------------------------------------------------------------
+// Root chain status
+
+// GetChainStatusKey returns key for root chain status
+func GetChainStatusKey(rootChain string) []byte {
+	return append(ChainStatusKey, []byte(rootChain)...)
+}
+
+// GetStoredChainStatus returns root chain status set through side-tx, chain is active by default
+func (k *Keeper) GetStoredChainStatus(ctx sdk.Context, rootChain string) types.ChainStatus {
+	store := ctx.KVStore(k.storeKey)
+	key := GetChainStatusKey(rootChain)
+	if store.Has(key) {
+		var chainStatus types.ChainStatus
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &chainStatus); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling chain status", "root", rootChain, "error", err)
+		} else {
+			return chainStatus
+		}
+	}
+
+	return types.NewActiveChainStatus(rootChain)
+}
+
+// GetChainStatus returns root chain status
+// status set in ParamsWithMultiChains and stored status are both honoured, the one changed at later height wins
+func (k *Keeper) GetChainStatus(ctx sdk.Context, rootChain string) types.ChainStatus {
+	chainStatus := k.GetStoredChainStatus(ctx, rootChain)
+
+	// retirement can't be reverted
+	if chainStatus.IsRetired() {
+		return chainStatus
+	}
+
+	if paramRaw, ok := k.GetParamsWithMultiChain(ctx).ChainParameterMap[rootChain]; ok {
+		param := paramRaw.Plainify()
+		if types.IsValidChainStatus(param.Status) && (param.Status == types.ChainStatusRetired || param.StatusHeight >= chainStatus.Height) {
+			return types.ChainStatus{
+				RootChainType:  rootChain,
+				Status:         param.Status,
+				PreviousStatus: chainStatus.StatusAt(uint64(ctx.BlockHeight())),
+				Height:         param.StatusHeight,
+			}
+		}
+	}
+
+	return chainStatus
+}
+
+// validateParamsWithMultiChain checks status changes of root chains set by governance
+func (k Keeper) validateParamsWithMultiChain(ctx sdk.Context, params types.ParamsWithMultiChains) error {
+	current := k.GetParamsWithMultiChain(ctx).ChainParameterMap
+
+	for rootChain, paramRaw := range params.ChainParameterMap {
+		param := paramRaw.Plainify()
+		if param.Status == "" {
+			continue
+		}
+
+		if !types.IsValidChainStatus(param.Status) {
+			return fmt.Errorf("invalid status %v of root chain %v", param.Status, rootChain)
+		}
+
+		// unchanged status
+		if currentRaw, ok := current[rootChain]; ok {
+			currentParam := currentRaw.Plainify()
+			if currentParam.Status == param.Status && currentParam.StatusHeight == param.StatusHeight {
+				continue
+			}
+		}
+
+		// stake chain keeps validator set, it can't be paused or retired
+		if rootChain == hmTypes.RootChainTypeStake && param.Status != types.ChainStatusActive {
+			return fmt.Errorf("status of stake chain %v can't be changed", rootChain)
+		}
+
+		if k.GetChainStatus(ctx, rootChain).IsRetired() {
+			return fmt.Errorf("root chain %v already retired", rootChain)
+		}
+	}
+
+	return nil
+}
+
+// SetChainStatus stores root chain status
+func (k *Keeper) SetChainStatus(ctx sdk.Context, chainStatus types.ChainStatus) error {
+	value, err := k.cdc.MarshalBinaryBare(chainStatus)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling chain status", "root", chainStatus.RootChainType, "error", err)
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetChainStatusKey(chainStatus.RootChainType), value)
+	k.Logger(ctx).Info("Updated chain status", "chainStatus", chainStatus)
+
+	return nil
+}
+
+// GetChainStatuses returns stored status of all root chains
+func (k *Keeper) GetChainStatuses(ctx sdk.Context) []types.ChainStatus {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ChainStatusKey)
+	defer iterator.Close()
+
+	var chainStatuses []types.ChainStatus
+	for ; iterator.Valid(); iterator.Next() {
+		var chainStatus types.ChainStatus
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &chainStatus); err == nil {
+			chainStatuses = append(chainStatuses, chainStatus)
+		}
+	}
+
+	return chainStatuses
+}
+
+// IsChainActive returns false if root chain is paused or retired at current height
+func (k *Keeper) IsChainActive(ctx sdk.Context, rootChain string) bool {
+	return k.GetChainStatus(ctx, rootChain).IsActiveAt(uint64(ctx.BlockHeight()))
+}
+
+// IsChainRetired returns true if root chain is retired at current height
+func (k *Keeper) IsChainRetired(ctx sdk.Context, rootChain string) bool {
+	return k.GetChainStatus(ctx, rootChain).StatusAt(uint64(ctx.BlockHeight())) == types.ChainStatusRetired
+}
+
+// GetRootChainContracts returns contract addresses of root chain
+func (k *Keeper) GetRootChainContracts(ctx sdk.Context, rootChain string) (helper.RootChainContracts, error) {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeTron:
+		return k.GetParams(ctx).ChainParams.RootChainContracts(rootChain)
+	}
+
+	chainInfo, err := k.GetChainParams(ctx, rootChain)
+	if err != nil {
+		return helper.RootChainContracts{}, err
+	}
+
+	return chainInfo.RootChainContracts(), nil
+}
+
+// GetTxConfirmations returns required tx confirmations on root chain
+func (k *Keeper) GetTxConfirmations(ctx sdk.Context, rootChain string) (uint64, error) {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return k.GetParams(ctx).MainchainTxConfirmations, nil
+	case hmTypes.RootChainTypeTron:
+		return k.GetParams(ctx).TronchainTxConfirmations, nil
+	}
+
+	chainInfo, err := k.GetChainParams(ctx, rootChain)
+	if err != nil {
+		return 0, err
+	}
+
+	return chainInfo.TxConfirmations, nil
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
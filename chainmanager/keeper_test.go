@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -37,3 +38,130 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestRootChainIDRegistry() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.ChainKeeper
+
+	// default chains resolve without store entries
+	require.Equal(t, byte(1), keeper.GetRootChainID(ctx, hmTypes.RootChainTypeTron))
+	require.Equal(t, byte(2), keeper.GetRootChainID(ctx, hmTypes.RootChainTypeEth))
+	require.Equal(t, byte(3), keeper.GetRootChainID(ctx, hmTypes.RootChainTypeBsc))
+	require.Equal(t, byte(0), keeper.GetRootChainID(ctx, "polygon"))
+
+	chainID, err := keeper.AllocateRootChainID(ctx, "polygon")
+	require.NoError(t, err)
+	require.Equal(t, byte(4), chainID)
+	require.Equal(t, chainID, keeper.GetRootChainID(ctx, "polygon"))
+	require.Equal(t, "polygon", keeper.GetRootChainName(ctx, 4))
+
+	// allocation stays in store, process wide defaults are untouched
+	require.Equal(t, byte(0), hmTypes.GetRootChainID("polygon"))
+
+	// allocation is idempotent
+	chainID, err = keeper.AllocateRootChainID(ctx, "polygon")
+	require.NoError(t, err)
+	require.Equal(t, byte(4), chainID)
+
+	chainID, err = keeper.AllocateRootChainID(ctx, "avax")
+	require.NoError(t, err)
+	require.Equal(t, byte(5), chainID)
+
+	require.Len(t, keeper.GetRootChainIDs(ctx), 5)
+	require.Len(t, keeper.GetStoredRootChainIDs(ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestChainStatus() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.ChainKeeper
+	ctx = ctx.WithBlockHeight(100)
+
+	// chain is active by default
+	require.True(t, keeper.IsChainActive(ctx, hmTypes.RootChainTypeBsc))
+
+	// pause takes effect from effective height
+	err := keeper.SetChainStatus(ctx, types.ChainStatus{
+		RootChainType:  hmTypes.RootChainTypeBsc,
+		Status:         types.ChainStatusPaused,
+		PreviousStatus: types.ChainStatusActive,
+		Height:         110,
+	})
+	require.NoError(t, err)
+	require.True(t, keeper.IsChainActive(ctx, hmTypes.RootChainTypeBsc))
+	require.False(t, keeper.IsChainActive(ctx.WithBlockHeight(110), hmTypes.RootChainTypeBsc))
+	require.True(t, keeper.IsChainActive(ctx, hmTypes.RootChainTypeEth))
+	require.False(t, keeper.IsChainRetired(ctx.WithBlockHeight(110), hmTypes.RootChainTypeBsc))
+
+	// governance overrides stored status
+	status := types.ChainStatusActive
+	statusHeight := uint64(120)
+	keeper.SetParamsWithMultiChain(ctx, types.ParamsWithMultiChains{
+		ChainParameterMap: map[string]types.ChainData{
+			hmTypes.RootChainTypeBsc: {Status: &status, StatusHeight: &statusHeight},
+		},
+	})
+	require.False(t, keeper.IsChainActive(ctx.WithBlockHeight(115), hmTypes.RootChainTypeBsc))
+	require.True(t, keeper.IsChainActive(ctx.WithBlockHeight(120), hmTypes.RootChainTypeBsc))
+
+	// stake chain can't be paused by governance
+	subspace, ok := app.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, ok)
+	err = subspace.Update(ctx, types.KeyParamsWithMultiChains,
+		[]byte(`{"chain_parameter_map":{"tron":{"status":"paused","status_height":"150"}}}`))
+	require.Error(t, err)
+	require.True(t, keeper.IsChainActive(ctx.WithBlockHeight(150), hmTypes.RootChainTypeStake))
+
+	// retirement can't be reverted by governance
+	err = keeper.SetChainStatus(ctx, types.ChainStatus{
+		RootChainType:  hmTypes.RootChainTypeBsc,
+		Status:         types.ChainStatusRetired,
+		PreviousStatus: types.ChainStatusActive,
+		Height:         130,
+	})
+	require.NoError(t, err)
+	require.False(t, keeper.IsChainActive(ctx.WithBlockHeight(130), hmTypes.RootChainTypeBsc))
+	require.True(t, keeper.GetChainStatus(ctx.WithBlockHeight(130), hmTypes.RootChainTypeBsc).IsRetired())
+	require.False(t, keeper.IsChainRetired(ctx.WithBlockHeight(125), hmTypes.RootChainTypeBsc))
+	require.True(t, keeper.IsChainRetired(ctx.WithBlockHeight(130), hmTypes.RootChainTypeBsc))
+	require.Len(t, keeper.GetChainStatuses(ctx), 1)
+
+	// ... nor by later status change of governance
+	err = subspace.Update(ctx, types.KeyParamsWithMultiChains,
+		[]byte(`{"chain_parameter_map":{"bsc":{"status":"active","status_height":"140"}}}`))
+	require.Error(t, err)
+	require.True(t, keeper.IsChainRetired(ctx.WithBlockHeight(140), hmTypes.RootChainTypeBsc))
+}
+
+func (suite *KeeperTestSuite) TestChainStatusRetiredByGovernance() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.ChainKeeper
+	ctx = ctx.WithBlockHeight(100)
+
+	subspace, ok := app.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	require.True(t, ok)
+
+	// later stored status wins over earlier status of governance
+	err := subspace.Update(ctx, types.KeyParamsWithMultiChains,
+		[]byte(`{"chain_parameter_map":{"bsc":{"status":"paused","status_height":"110"}}}`))
+	require.NoError(t, err)
+	err = keeper.SetChainStatus(ctx, types.ChainStatus{
+		RootChainType:  hmTypes.RootChainTypeBsc,
+		Status:         types.ChainStatusActive,
+		PreviousStatus: types.ChainStatusPaused,
+		Height:         120,
+	})
+	require.NoError(t, err)
+	require.False(t, keeper.IsChainActive(ctx.WithBlockHeight(115), hmTypes.RootChainTypeBsc))
+	require.True(t, keeper.IsChainActive(ctx.WithBlockHeight(120), hmTypes.RootChainTypeBsc))
+
+	// retirement by governance is final
+	err = subspace.Update(ctx, types.KeyParamsWithMultiChains,
+		[]byte(`{"chain_parameter_map":{"bsc":{"status":"retired","status_height":"130"}}}`))
+	require.NoError(t, err)
+	require.True(t, keeper.IsChainRetired(ctx.WithBlockHeight(130), hmTypes.RootChainTypeBsc))
+
+	err = subspace.Update(ctx, types.KeyParamsWithMultiChains,
+		[]byte(`{"chain_parameter_map":{"bsc":{"status":"active","status_height":"140"}}}`))
+	require.Error(t, err)
+	require.True(t, keeper.IsChainRetired(ctx.WithBlockHeight(140), hmTypes.RootChainTypeBsc))
+}
@@ -21,6 +21,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryParamsWithTargetChain(ctx, req, keeper)
 		case types.QueryProposalChainParamMap:
 			return queryPropsoalChainParamMap(ctx, keeper)
+		case types.QueryRootChainIDs:
+			return queryRootChainIDs(ctx, keeper)
+		case types.QueryNextRootChainID:
+			return queryNextRootChainID(ctx, keeper)
+		case types.QueryChainStatus:
+			return queryChainStatus(ctx, req, keeper)
+		case types.QueryRootChainContracts:
+			return queryRootChainContracts(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown chainmanager query endpoint")
 		}
@@ -42,8 +50,8 @@ func queryNewChainParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
 	}
 	response := keeper.GetParams(ctx)
-	newChainParams, _ := keeper.GetChainParams(ctx, params.RootChain)
-	if params.RootChain == hmTpyes.RootChainTypeBsc {
+	newChainParams, err := keeper.GetChainParams(ctx, params.RootChain)
+	if err == nil && params.RootChain != hmTpyes.RootChainTypeEth && params.RootChain != hmTpyes.RootChainTypeTron {
 		response.MainchainTxConfirmations = newChainParams.TxConfirmations
 		response.ChainParams.RootChainAddress = newChainParams.RootChainAddress
 		response.ChainParams.StateSenderAddress = newChainParams.StateSenderAddress
@@ -57,6 +65,66 @@ func queryNewChainParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return bz, nil
 }
 
+func queryRootChainIDs(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetRootChainIDs(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryNextRootChainID(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	rootChainID, err := keeper.GetNextRootChainID(ctx)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get next root chain id", err.Error()))
+	}
+
+	bz, err := json.Marshal(rootChainID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryChainStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryChainParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	chainStatus := keeper.GetChainStatus(ctx, params.RootChain)
+	bz, err := json.Marshal(types.ChainStatusResponse{
+		ChainStatus: chainStatus,
+		Active:      chainStatus.IsActiveAt(uint64(ctx.BlockHeight())),
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryRootChainContracts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryChainParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	contracts, err := keeper.GetRootChainContracts(ctx, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get root chain contracts", err.Error()))
+	}
+
+	bz, err := json.Marshal(contracts)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryPropsoalChainParamMap(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	bz, err := json.Marshal(keeper.GetParamsWithMultiChain(ctx))
 	if err != nil {
@@ -9,6 +9,8 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/stretchr/testify/suite"
@@ -90,3 +92,59 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 		})
 	}
 }
+
+// TestQueryRootChainContracts queries contracts of root chains
+func (suite *QuerierTestSuite) TestQueryRootChainContracts() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryRootChainContracts}
+	query := func(rootChain string) (helper.RootChainContracts, error) {
+		var contracts helper.RootChainContracts
+
+		res, err := querier(ctx, path, abci.RequestQuery{Data: app.Codec().MustMarshalJSON(types.NewQueryChainParams(rootChain))})
+		if err != nil {
+			return contracts, err
+		}
+
+		require.NoError(t, json.Unmarshal(res, &contracts))
+		return contracts, nil
+	}
+
+	contracts, err := query(hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().ChainParams.RootChainAddress.String(), contracts.RootChainAddress)
+
+	// chains without chain info don't fall back to eth contracts
+	_, err = query(hmTypes.RootChainTypeBsc)
+	require.Error(t, err)
+
+	rootChainAddress := hmTypes.HexToHeimdallAddress("0x6c468cf8c9879006e22ec4029696e005c2319c9d")
+	require.NoError(t, app.ChainKeeper.AddNewChainParams(ctx, types.ChainInfo{
+		RootChainType:    hmTypes.RootChainTypeBsc,
+		RootChainAddress: rootChainAddress,
+	}))
+
+	contracts, err = query(hmTypes.RootChainTypeBsc)
+	require.NoError(t, err)
+	require.Equal(t, rootChainAddress.String(), contracts.RootChainAddress)
+}
+
+// TestQueryNextRootChainID queries id allocated to next new root chain
+func (suite *QuerierTestSuite) TestQueryNextRootChainID() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	query := func() byte {
+		res, err := querier(ctx, []string{types.QueryNextRootChainID}, abci.RequestQuery{})
+		require.NoError(t, err)
+
+		var rootChainID byte
+		require.NoError(t, json.Unmarshal(res, &rootChainID))
+		return rootChainID
+	}
+
+	require.Equal(t, byte(4), query())
+
+	_, err := app.ChainKeeper.AllocateRootChainID(ctx, "polygon")
+	require.NoError(t, err)
+	require.Equal(t, byte(5), query())
+}
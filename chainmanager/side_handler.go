@@ -23,6 +23,10 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 		switch msg := msg.(type) {
 		case types.MsgNewChain:
 			return SideHandleMsgNewChain(ctx, msg, k, contractCaller)
+		case types.MsgPauseChain:
+			return SideHandleMsgPauseChain(ctx, msg, k, contractCaller)
+		case types.MsgRetireChain:
+			return SideHandleMsgRetireChain(ctx, msg, k, contractCaller)
 
 		default:
 			return abci.ResponseDeliverSideTx{
@@ -64,9 +68,23 @@ func SideHandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, con
 			"MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
-	if uint64(hmTypes.GetRootChainID(msg.RootChainType)) != eventLog.RootChainId.Uint64() {
+	if msg.RootChainType != eventLog.RootChainType {
 		k.Logger(ctx).Error("RootChainType in message doesn't match with receipt",
-			"MsgRootChainType", msg.RootChainType, "ReceiptRootChainType", eventLog.RootChainId.Uint64())
+			"MsgRootChainType", msg.RootChainType, "ReceiptRootChainType", eventLog.RootChainType)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+	// new chain gets next unused root chain id
+	rootChainID := k.GetRootChainID(ctx, msg.RootChainType)
+	if rootChainID == 0 {
+		rootChainID, err = k.GetNextRootChainID(ctx)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to allocate root chain id", "error", err)
+			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
+	}
+	if uint64(rootChainID) != eventLog.RootChainId.Uint64() {
+		k.Logger(ctx).Error("RootChainId of message doesn't match with receipt",
+			"MsgRootChainType", msg.RootChainType, "RootChainId", rootChainID, "ReceiptRootChainId", eventLog.RootChainId.Uint64())
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 	if msg.TxConfirmations != eventLog.TxConfirmations.Uint64() {
@@ -92,6 +110,94 @@ func SideHandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, con
 	return
 }
 
+// SideHandleMsgPauseChain side msg pause chain
+func SideHandleMsgPauseChain(ctx sdk.Context, msg types.MsgPauseChain, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for pause chain msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	if code := verifyChainStatusChanged(ctx, k, contractCaller, msg.RootChainType, msg.Status(),
+		msg.EffectiveHeight, msg.TxHash, msg.LogIndex, msg.BlockNumber); code != 0 {
+		return common.ErrorSideTx(k.Codespace(), code)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for pause chain msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgRetireChain side msg retire chain
+func SideHandleMsgRetireChain(ctx sdk.Context, msg types.MsgRetireChain, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for retire chain msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	if code := verifyChainStatusChanged(ctx, k, contractCaller, msg.RootChainType, types.ChainStatusRetired,
+		msg.EffectiveHeight, msg.TxHash, msg.LogIndex, msg.BlockNumber); code != 0 {
+		return common.ErrorSideTx(k.Codespace(), code)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for retire chain msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// verifyChainStatusChanged checks chain status change event emitted on tron
+func verifyChainStatusChanged(
+	ctx sdk.Context,
+	k Keeper,
+	contractCaller helper.IContractCaller,
+	rootChain string,
+	status string,
+	effectiveHeight uint64,
+	txHash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) common.CodeType {
+	chainParams := k.GetParams(ctx).ChainParams
+
+	// get event log on tron
+	receipt, err := contractCaller.GetTronTransactionReceipt(txHash.Hex())
+	if err != nil || receipt == nil {
+		return common.CodeWaitFrConfirmation
+	}
+
+	eventLog, err := contractCaller.DecodeChainStatusChangedEvent(hmTypes.HexToTronAddress(chainParams.TronChainAddress), receipt, logIndex)
+	if err != nil || eventLog == nil {
+		return common.CodeErrDecodeEvent
+	}
+
+	if blockNumber != receipt.BlockNumber.Uint64() {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match with receipt",
+			"MsgBlockNumber", blockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return common.CodeInvalidMsg
+	}
+
+	if uint64(k.GetRootChainID(ctx, rootChain)) != eventLog.RootChainId.Uint64() {
+		k.Logger(ctx).Error("RootChainType in message doesn't match with receipt",
+			"MsgRootChainType", rootChain, "ReceiptRootChainType", eventLog.RootChainId.Uint64())
+		return common.CodeInvalidMsg
+	}
+
+	if status != types.ChainStatusFromEvent[eventLog.Status] {
+		k.Logger(ctx).Error("Status in message doesn't match with receipt",
+			"MsgStatus", status, "ReceiptStatus", eventLog.Status)
+		return common.CodeInvalidMsg
+	}
+
+	if effectiveHeight != eventLog.EffectiveHeight.Uint64() {
+		k.Logger(ctx).Error("EffectiveHeight in message doesn't match with receipt",
+			"MsgEffectiveHeight", effectiveHeight, "ReceiptEffectiveHeight", eventLog.EffectiveHeight.Uint64())
+		return common.CodeInvalidMsg
+	}
+
+	return 0
+}
+
 // NewPostTxHandler returns a side handler for "chainmanager" type messages.
 func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
 	return func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
@@ -100,6 +206,10 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 		switch msg := msg.(type) {
 		case types.MsgNewChain:
 			return PostHandleMsgMsgNewChain(ctx, k, msg, sideTxResult)
+		case types.MsgPauseChain:
+			return PostHandleMsgPauseChain(ctx, k, msg, sideTxResult)
+		case types.MsgRetireChain:
+			return PostHandleMsgRetireChain(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unrecognized Staking Msg type").Result()
 		}
@@ -121,8 +231,20 @@ func PostHandleMsgMsgNewChain(ctx sdk.Context, k Keeper, msg types.MsgNewChain,
 
 	k.Logger(ctx).Debug("Adding new chain to state", "sideTxResult", sideTxResult)
 
+	if _, err := k.GetChainParams(ctx, msg.RootChainType); err == nil {
+		k.Logger(ctx).Error("Chain params already exist", "root", msg.RootChainType)
+		return common.ErrChainPamramsExist(k.Codespace()).Result()
+	}
+
+	// allocate root chain id
+	rootChainID, err := k.AllocateRootChainID(ctx, msg.RootChainType)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to allocate root chain id", "error", err, "root", msg.RootChainType)
+		return common.ErrWrongRootChain(k.Codespace()).Result()
+	}
+
 	// add validator to store=
-	err := k.AddNewChainParams(ctx, types.ChainInfo{
+	err = k.AddNewChainParams(ctx, types.ChainInfo{
 		RootChainType:         msg.RootChainType,
 		ActivationHeight:      msg.ActivationHeight,
 		TxConfirmations:       msg.TxConfirmations,
@@ -153,6 +275,106 @@ func PostHandleMsgMsgNewChain(ctx sdk.Context, k Keeper, msg types.MsgNewChain,
 			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()), // result
 			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatUint(msg.ActivationHeight, 10)),
 			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyRootChainID, strconv.FormatUint(uint64(rootChainID), 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgPauseChain msg pause chain
+func PostHandleMsgPauseChain(ctx sdk.Context, k Keeper, msg types.MsgPauseChain, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if pause chain is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping pause chain msg since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	if err := updateChainStatus(ctx, k, msg.RootChainType, msg.Status(), msg.EffectiveHeight); err != nil {
+		return err.Result()
+	}
+
+	k.Logger(ctx).Debug("✅ Chain status successfully updated", "root", msg.RootChainType, "status", msg.Status())
+
+	return emitChainStatusEvent(ctx, types.EventTypePauseChain, msg.Type(), msg.RootChainType, msg.Status(),
+		msg.EffectiveHeight, msg.LogIndex, sideTxResult)
+}
+
+// PostHandleMsgRetireChain msg retire chain
+func PostHandleMsgRetireChain(ctx sdk.Context, k Keeper, msg types.MsgRetireChain, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if retire chain is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping retire chain msg since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	if err := updateChainStatus(ctx, k, msg.RootChainType, types.ChainStatusRetired, msg.EffectiveHeight); err != nil {
+		return err.Result()
+	}
+
+	k.Logger(ctx).Debug("✅ Chain successfully retired", "root", msg.RootChainType, "effectiveHeight", msg.EffectiveHeight)
+
+	return emitChainStatusEvent(ctx, types.EventTypeRetireChain, msg.Type(), msg.RootChainType, types.ChainStatusRetired,
+		msg.EffectiveHeight, msg.LogIndex, sideTxResult)
+}
+
+// updateChainStatus stores new status of root chain
+func updateChainStatus(ctx sdk.Context, k Keeper, rootChain string, status string, effectiveHeight uint64) sdk.Error {
+	// stake chain keeps validator set, it can't be paused or retired
+	if rootChain == hmTypes.RootChainTypeStake {
+		k.Logger(ctx).Error("Wrong root chain type", "root", rootChain)
+		return common.ErrWrongRootChain(k.Codespace())
+	}
+
+	// retirement by governance is final as well
+	if k.GetChainStatus(ctx, rootChain).IsRetired() {
+		k.Logger(ctx).Error("Root chain already retired", "root", rootChain)
+		return common.ErrChainInactive(k.Codespace())
+	}
+
+	currentStatus := k.GetStoredChainStatus(ctx, rootChain)
+
+	chainStatus := types.ChainStatus{
+		RootChainType:  rootChain,
+		Status:         status,
+		PreviousStatus: currentStatus.StatusAt(uint64(ctx.BlockHeight())),
+		Height:         effectiveHeight,
+	}
+	if err := k.SetChainStatus(ctx, chainStatus); err != nil {
+		k.Logger(ctx).Error("Unable to update chain status", "error", err, "root", rootChain)
+		return common.ErrWrongRootChain(k.Codespace())
+	}
+
+	return nil
+}
+
+func emitChainStatusEvent(
+	ctx sdk.Context,
+	eventType string,
+	action string,
+	rootChain string,
+	status string,
+	effectiveHeight uint64,
+	logIndex uint64,
+	sideTxResult abci.SideTxResultType,
+) sdk.Result {
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyAction, action),                                      // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeyTxLogIndex, strconv.FormatUint(logIndex, 10)),
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()), // result
+			sdk.NewAttribute(types.AttributeKeyRootChain, rootChain),
+			sdk.NewAttribute(types.AttributeKeyChainStatus, status),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatUint(effectiveHeight, 10)),
 		),
 	})
 
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -25,3 +27,113 @@ func (s *ChainInfo) String() string {
 		s.RootChainType, s.ActivationHeight, s.TxConfirmations, s.RootChainAddress, s.StateSenderAddress, s.StakingManagerAddress, s.StakingInfoAddress,
 	)
 }
+
+// RootChainContracts returns contract addresses of the chain
+func (s *ChainInfo) RootChainContracts() helper.RootChainContracts {
+	return helper.RootChainContracts{
+		RootChainAddress:      s.RootChainAddress.String(),
+		StakingManagerAddress: s.StakingManagerAddress.String(),
+		StakingInfoAddress:    s.StakingInfoAddress.String(),
+		StateSenderAddress:    s.StateSenderAddress.String(),
+	}
+}
+
+// RootChainID represents id allocated to root chain
+type RootChainID struct {
+	RootChainType string `json:"root_chain_type" yaml:"root_chain_type"`
+	ChainID       byte   `json:"chain_id" yaml:"chain_id"`
+}
+
+// NewRootChainID creates new root chain id
+func NewRootChainID(rootChain string, chainID byte) RootChainID {
+	return RootChainID{
+		RootChainType: rootChain,
+		ChainID:       chainID,
+	}
+}
+
+// String returns the string representation of root chain id
+func (r RootChainID) String() string {
+	return fmt.Sprintf("RootChainType: %v, ChainID: %v", r.RootChainType, r.ChainID)
+}
+
+// RootChainIDs list of root chain ids
+type RootChainIDs []RootChainID
+
+// String returns the string representation of root chain ids
+func (r RootChainIDs) String() string {
+	var sb strings.Builder
+	for _, rootChainID := range r {
+		sb.WriteString(rootChainID.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Root chain status values
+const (
+	ChainStatusActive  = "active"
+	ChainStatusPaused  = "paused"
+	ChainStatusRetired = "retired"
+)
+
+// ChainStatusFromEvent maps status emitted by root chain contract to chain status
+var ChainStatusFromEvent = map[uint8]string{
+	0: ChainStatusActive,
+	1: ChainStatusPaused,
+	2: ChainStatusRetired,
+}
+
+// ChainStatus represents status of root chain, new status takes effect from height
+type ChainStatus struct {
+	RootChainType  string `json:"root_chain_type" yaml:"root_chain_type"`
+	Status         string `json:"status" yaml:"status"`
+	PreviousStatus string `json:"previous_status" yaml:"previous_status"`
+	Height         uint64 `json:"height" yaml:"height"`
+}
+
+// NewActiveChainStatus creates status for chain without any status change
+func NewActiveChainStatus(rootChain string) ChainStatus {
+	return ChainStatus{
+		RootChainType:  rootChain,
+		Status:         ChainStatusActive,
+		PreviousStatus: ChainStatusActive,
+	}
+}
+
+// String returns the string representation of chain status
+func (s ChainStatus) String() string {
+	return fmt.Sprintf(
+		"RootChainType: %v, Status: %v, PreviousStatus: %v, Height: %v",
+		s.RootChainType, s.Status, s.PreviousStatus, s.Height,
+	)
+}
+
+// StatusAt returns status of chain at height
+func (s ChainStatus) StatusAt(height uint64) string {
+	if height >= s.Height {
+		return s.Status
+	}
+
+	return s.PreviousStatus
+}
+
+// IsActiveAt returns true if chain accepts checkpoints and sync traffic at height
+func (s ChainStatus) IsActiveAt(height uint64) bool {
+	return s.StatusAt(height) == ChainStatusActive
+}
+
+// IsRetired returns true if chain is retired, retirement can't be reverted
+func (s ChainStatus) IsRetired() bool {
+	return s.Status == ChainStatusRetired
+}
+
+// IsValidChainStatus checks chain status value
+func IsValidChainStatus(status string) bool {
+	switch status {
+	case ChainStatusActive, ChainStatusPaused, ChainStatusRetired:
+		return true
+	}
+
+	return false
+}
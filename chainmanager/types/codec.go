@@ -16,4 +16,6 @@ func init() {
 // RegisterCodec registers all necessary param module types with a given codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgNewChain{}, "chainmanager/MsgNewChain", nil)
+	cdc.RegisterConcrete(MsgPauseChain{}, "chainmanager/MsgPauseChain", nil)
+	cdc.RegisterConcrete(MsgRetireChain{}, "chainmanager/MsgRetireChain", nil)
 }
@@ -2,10 +2,15 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeNewChain = "new-chain"
+	EventTypeNewChain    = "new-chain"
+	EventTypePauseChain  = "pause-chain"
+	EventTypeRetireChain = "retire-chain"
 
 	AttributeKeyActivationHeight = "activation-height"
 	AttributeKeyRootChain        = "root-chain"
+	AttributeKeyRootChainID      = "root-chain-id"
+	AttributeKeyChainStatus      = "chain-status"
+	AttributeKeyEffectiveHeight  = "effective-height"
 
 	AttributeValueCategory = ModuleName
 )
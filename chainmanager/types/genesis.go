@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 //
@@ -13,24 +14,59 @@ type GenesisState struct {
 	Params Params `json:"params" yaml:"params"`
 
 	ChainInfos []ChainInfo `json:"chain_infos" yaml:"chain_infos"`
+
+	RootChainIDs []RootChainID `json:"root_chain_ids" yaml:"root_chain_ids"`
+
+	ChainStatuses []ChainStatus `json:"chain_statuses" yaml:"chain_statuses"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params, chainInfos []ChainInfo) GenesisState {
+func NewGenesisState(
+	params Params,
+	chainInfos []ChainInfo,
+	rootChainIDs []RootChainID,
+	chainStatuses []ChainStatus,
+) GenesisState {
 	return GenesisState{
-		Params:     params,
-		ChainInfos: chainInfos,
+		Params:        params,
+		ChainInfos:    chainInfos,
+		RootChainIDs:  rootChainIDs,
+		ChainStatuses: chainStatuses,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), []ChainInfo{})
+	return NewGenesisState(DefaultParams(), []ChainInfo{}, []RootChainID{}, []ChainStatus{})
 }
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	rootChains := make(map[string]bool)
+	chainIDs := make(map[byte]bool)
+
+	for _, rootChainID := range data.RootChainIDs {
+		if rootChainID.RootChainType == "" || rootChainID.ChainID == 0 {
+			return fmt.Errorf("invalid root chain id %v", rootChainID.String())
+		}
+
+		if rootChains[rootChainID.RootChainType] || chainIDs[rootChainID.ChainID] {
+			return fmt.Errorf("duplicate root chain id %v", rootChainID.String())
+		}
+
+		rootChains[rootChainID.RootChainType] = true
+		chainIDs[rootChainID.ChainID] = true
+	}
+
+	for _, chainStatus := range data.ChainStatuses {
+		if chainStatus.RootChainType == "" ||
+			!IsValidChainStatus(chainStatus.Status) ||
+			!IsValidChainStatus(chainStatus.PreviousStatus) {
+			return fmt.Errorf("invalid chain status %v", chainStatus.String())
+		}
+	}
+
 	return nil
 }
 
@@ -114,3 +114,163 @@ func (msg MsgNewChain) ValidateBasic() sdk.Error {
 func (msg MsgNewChain) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// Chain status
+//
+
+var _ sdk.Msg = &MsgPauseChain{}
+
+// MsgPauseChain pauses or resumes root chain from effective height
+type MsgPauseChain struct {
+	From            hmTypes.HeimdallAddress `json:"from"`
+	RootChainType   string                  `json:"root_chain_type" yaml:"root_chain_type"`
+	Paused          bool                    `json:"paused" yaml:"paused"`
+	EffectiveHeight uint64                  `json:"effective_height" yaml:"effective_height"`
+	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                  `json:"log_index"`
+	BlockNumber     uint64                  `json:"block_number"`
+}
+
+// NewMsgPauseChain creates new pause-chain msg
+func NewMsgPauseChain(
+	from hmTypes.HeimdallAddress,
+	rootChain string,
+	paused bool,
+	effectiveHeight uint64,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgPauseChain {
+	return MsgPauseChain{
+		From:            from,
+		RootChainType:   rootChain,
+		Paused:          paused,
+		EffectiveHeight: effectiveHeight,
+		TxHash:          txhash,
+		LogIndex:        logIndex,
+		BlockNumber:     blockNumber,
+	}
+}
+
+func (msg MsgPauseChain) Type() string {
+	return "pause-chain"
+}
+
+func (msg MsgPauseChain) Route() string {
+	return RouterKey
+}
+
+func (msg MsgPauseChain) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgPauseChain) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgPauseChain) ValidateBasic() sdk.Error {
+	if msg.RootChainType == "" {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain type %v", msg.RootChainType)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.TxHash.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tx hash %v", msg.TxHash.Hex())
+	}
+
+	return nil
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgPauseChain) GetSideSignBytes() []byte {
+	return nil
+}
+
+// Status returns chain status set by msg
+func (msg MsgPauseChain) Status() string {
+	if msg.Paused {
+		return ChainStatusPaused
+	}
+
+	return ChainStatusActive
+}
+
+var _ sdk.Msg = &MsgRetireChain{}
+
+// MsgRetireChain retires root chain from effective height
+type MsgRetireChain struct {
+	From            hmTypes.HeimdallAddress `json:"from"`
+	RootChainType   string                  `json:"root_chain_type" yaml:"root_chain_type"`
+	EffectiveHeight uint64                  `json:"effective_height" yaml:"effective_height"`
+	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                  `json:"log_index"`
+	BlockNumber     uint64                  `json:"block_number"`
+}
+
+// NewMsgRetireChain creates new retire-chain msg
+func NewMsgRetireChain(
+	from hmTypes.HeimdallAddress,
+	rootChain string,
+	effectiveHeight uint64,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgRetireChain {
+	return MsgRetireChain{
+		From:            from,
+		RootChainType:   rootChain,
+		EffectiveHeight: effectiveHeight,
+		TxHash:          txhash,
+		LogIndex:        logIndex,
+		BlockNumber:     blockNumber,
+	}
+}
+
+func (msg MsgRetireChain) Type() string {
+	return "retire-chain"
+}
+
+func (msg MsgRetireChain) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRetireChain) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgRetireChain) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgRetireChain) ValidateBasic() sdk.Error {
+	if msg.RootChainType == "" {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain type %v", msg.RootChainType)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.TxHash.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tx hash %v", msg.TxHash.Hex())
+	}
+
+	return nil
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgRetireChain) GetSideSignBytes() []byte {
+	return nil
+}
@@ -70,6 +70,29 @@ func (cp ChainParams) String() string {
 		cp.BorChainID, cp.MaticTokenAddress, cp.StakingManagerAddress, cp.SlashManagerAddress, cp.RootChainAddress, cp.StakingInfoAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
 }
 
+// RootChainContracts returns contract addresses of eth or tron, contracts of other
+// root chains are stored in their chain info
+func (cp ChainParams) RootChainContracts(rootChain string) (helper.RootChainContracts, error) {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return helper.RootChainContracts{
+			RootChainAddress:      cp.RootChainAddress.String(),
+			StakingManagerAddress: cp.StakingManagerAddress.String(),
+			StakingInfoAddress:    cp.StakingInfoAddress.String(),
+			StateSenderAddress:    cp.StateSenderAddress.String(),
+		}, nil
+	case hmTypes.RootChainTypeTron:
+		return helper.RootChainContracts{
+			RootChainAddress:      cp.TronChainAddress,
+			StakingManagerAddress: cp.TronStakingManagerAddress,
+			StakingInfoAddress:    cp.TronStakingInfoAddress,
+			StateSenderAddress:    cp.TronStateSenderAddress,
+		}, nil
+	}
+
+	return helper.RootChainContracts{}, fmt.Errorf("contracts of root chain %v are not in chain params", rootChain)
+}
+
 // Params defines the parameters for the chainmanager module.
 type Params struct {
 	MainchainTxConfirmations  uint64      `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
@@ -194,6 +217,10 @@ type ChainData struct {
 	TxConfirmations *uint64 `json:"tx_confirmations" yaml:"tx_confirmations"`
 	ActivateHeight  *uint64 `json:"activate_height" yaml:"activate_height"`
 
+	// pause or retire chain from status height
+	Status       *string `json:"status,omitempty" yaml:"status,omitempty"`
+	StatusHeight *uint64 `json:"status_height,omitempty" yaml:"status_height,omitempty"`
+
 	// main chain
 	StakingManagerAddress *hmTypes.HeimdallAddress `json:"staking_manager_address" yaml:"staking_manager_address"`
 	SlashManagerAddress   *hmTypes.HeimdallAddress `json:"slash_manager_address" yaml:"slash_manager_address"`
@@ -212,6 +239,9 @@ type PlainChainData struct {
 	TxConfirmations uint64 `json:"tx_confirmations" yaml:"tx_confirmations"`
 	ActivateHeight  uint64 `json:"activate_height" yaml:"activate_height"`
 
+	Status       string `json:"status" yaml:"status"`
+	StatusHeight uint64 `json:"status_height" yaml:"status_height"`
+
 	// main chain
 	StakingManagerAddress hmTypes.HeimdallAddress `json:"staking_manager_address" yaml:"staking_manager_address"`
 	SlashManagerAddress   hmTypes.HeimdallAddress `json:"slash_manager_address" yaml:"slash_manager_address"`
@@ -229,6 +259,14 @@ func (cd *ChainData) Plainify() (pc PlainChainData) {
 		pc.ActivateHeight = *cd.ActivateHeight
 	}
 
+	if cd.Status != nil {
+		pc.Status = *cd.Status
+	}
+
+	if cd.StatusHeight != nil {
+		pc.StatusHeight = *cd.StatusHeight
+	}
+
 	if cd.StakingManagerAddress != nil {
 		pc.StakingManagerAddress = *cd.StakingManagerAddress
 	}
@@ -275,6 +313,8 @@ func (pmc ParamsWithMultiChains) String() string {
 		[chain]: %s
 		TxConfirmations:					%v,
 		ActivateHeight:						%v,
+		Status:								%s,
+		StatusHeight:						%v,
 
 		StakingManagerAddress:				%s,
 		SlashManagerAddress:				%s,
@@ -282,7 +322,7 @@ func (pmc ParamsWithMultiChains) String() string {
 		StakingInfoAddress:					%s,
 		StateSenderAddress:					%s,
 		`,
-			key, pVal.TxConfirmations, pVal.ActivateHeight,
+			key, pVal.TxConfirmations, pVal.ActivateHeight, pVal.Status, pVal.StatusHeight,
 			pVal.StakingManagerAddress, pVal.SlashManagerAddress, pVal.RootChainAddress,
 			pVal.StakingInfoAddress, pVal.StateSenderAddress)
 	}
@@ -1,10 +1,16 @@
 package types
 
+import "fmt"
+
 // query endpoints supported by the chain-manager Querier
 const (
 	QueryParams                = "params"
 	QueryNewChainParam         = "chain-params"
 	QueryProposalChainParamMap = "proposal-chain-param-map"
+	QueryRootChainIDs          = "root-chain-ids"
+	QueryNextRootChainID       = "next-root-chain-id"
+	QueryChainStatus           = "chain-status"
+	QueryRootChainContracts    = "root-chain-contracts"
 )
 
 // QueryChainParams defines the params for querying accounts.
@@ -18,3 +24,14 @@ func NewQueryChainParams(rootChain string) QueryChainParams {
 		RootChain: rootChain,
 	}
 }
+
+// ChainStatusResponse chain status with activity at query height
+type ChainStatusResponse struct {
+	ChainStatus
+	Active bool `json:"active" yaml:"active"`
+}
+
+// String returns the string representation of chain status response
+func (r ChainStatusResponse) String() string {
+	return fmt.Sprintf("%v, Active: %v", r.ChainStatus.String(), r.Active)
+}
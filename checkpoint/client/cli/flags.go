@@ -13,4 +13,5 @@ const (
 	FlagAutoConfigure      = "auto-configure"
 	FlagEpoch              = "epoch"
 	FlagRootChain          = "root-chain"
+	FlagBlockNumber        = "block"
 )
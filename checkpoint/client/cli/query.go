@@ -34,10 +34,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetQueryParams(cdc),
 			GetCheckpointBuffer(cdc),
+			GetPendingCheckpoints(cdc),
 			GetLastNoACK(cdc),
 			GetCheckpointByNumber(cdc),
 			GetCheckpointCount(cdc),
 			GetQueryActivateHeight(cdc),
+			GetCheckpointProof(cdc),
+			GetCheckpointByBlock(cdc),
 		)...,
 	)
 
@@ -110,6 +113,35 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetPendingCheckpoints get checkpoints waiting for ack
+func GetPendingCheckpoints(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-checkpoints",
+		Short: "show checkpoints waiting for ack, buffered checkpoint first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootChain := viper.GetString(FlagRootChain)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+			if err != nil {
+				return errors.New("rootChain Error :" + rootChain)
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingCheckpoints), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>")
+	if err := cmd.MarkFlagRequired(FlagRootChain); err != nil {
+		logger.Error("GetPendingCheckpoints | MarkFlagRequired | FlagRootChain", "Error", err)
+	}
+	return cmd
+}
+
 // GetLastNoACK get last no ack time
 func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -241,3 +273,102 @@ func GetQueryActivateHeight(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCheckpointProof get inclusion proof of a child block against its checkpoint
+func GetCheckpointProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof",
+		Short: "get merkle inclusion proof of a block against the checkpoint covering it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query block inclusion proof against committed checkpoint root hash.
+
+Example:
+$ %s query checkpoint proof --block=1000 --root-chain=tron
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			blockNumber := viper.GetUint64(FlagBlockNumber)
+			rootChain := viper.GetString(FlagRootChain)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockProofParams(blockNumber, rootChain))
+			if err != nil {
+				return err
+			}
+
+			// fetch proof
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointProof), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("No proof found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block=<block-number>")
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>")
+	if err := cmd.MarkFlagRequired(FlagBlockNumber); err != nil {
+		logger.Error("GetCheckpointProof | MarkFlagRequired | FlagBlockNumber", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCheckpointByBlock get checkpoints covering a child block with ack status on each root chain
+func GetCheckpointByBlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-by-block",
+		Short: "get checkpoint covering a block and its ack status on each root chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query checkpoint covering a child block on each root chain, or only on given root chain.
+
+Example:
+$ %s query checkpoint checkpoint-by-block --block=1000
+$ %s query checkpoint checkpoint-by-block --block=1000 --root-chain=tron
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			blockNumber := viper.GetUint64(FlagBlockNumber)
+			rootChain := viper.GetString(FlagRootChain)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockProofParams(blockNumber, rootChain))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoints
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("No checkpoint found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block=<block-number>")
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>")
+	if err := cmd.MarkFlagRequired(FlagBlockNumber); err != nil {
+		logger.Error("GetCheckpointByBlock | MarkFlagRequired | FlagBlockNumber", "Error", err)
+	}
+
+	return cmd
+}
@@ -6,10 +6,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 
-	ethTypes "github.com/ethereum/go-ethereum/core/types"
-
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +18,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	types "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/helper"
@@ -44,6 +44,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendCheckpointNoACKTx(cdc),
 		)...,
 	)
+	txCmd.AddCommand(RelayCheckpointTx(cdc))
 	return txCmd
 }
 
@@ -219,38 +220,35 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			adapter, err := helper.GetRootChainAdapter(&contractCallerObj, rootChain)
+			if err != nil {
+				return err
+			}
+
+			contracts, err := util.GetRootChainContracts(cliCtx, rootChain)
+			if err != nil {
+				return err
+			}
+
 			chainmanagerParams, err := util.GetNewChainParams(cliCtx, rootChain)
 			if err != nil {
 				return err
 			}
 
+			// finalized blocks are only used for eth
+			finalizedEthOpen := false
+			if rootChain == hmTypes.RootChainTypeEth {
+				finalizedEthOpen = util.GetFinalizedEthOpen(cliCtx)
+			}
+
 			// get main tx receipt
-			var receipt *ethTypes.Receipt
-			var rootChainAddress common.Address
-			switch rootChain {
-			case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc:
-				finalizedEthOpen := false
-				if rootChain == hmTypes.RootChainTypeEth {
-					finalizedEthOpen = util.GetFinalizedEthOpen(cliCtx)
-				}
-				receipt, err = contractCallerObj.GetConfirmedTxReceipt(txHash.EthHash(),
-					chainmanagerParams.MainchainTxConfirmations, rootChain, finalizedEthOpen)
-				if err != nil || receipt == nil {
-					return errors.New("transaction is not confirmed yet. Please wait for sometime and try again")
-				}
-				rootChainAddress = chainmanagerParams.ChainParams.RootChainAddress.EthAddress()
-			case hmTypes.RootChainTypeTron:
-				receipt, err = contractCallerObj.GetTronTransactionReceipt(txHash.Hex())
-				if err != nil || receipt == nil {
-					return errors.New("transaction is not confirmed yet. Please wait for sometime and try again")
-				}
-				rootChainAddress = hmTypes.HexToTronAddress(chainmanagerParams.ChainParams.TronChainAddress)
-			default:
-				return fmt.Errorf("wrong root chain %v", rootChain)
+			receipt, err := adapter.GetConfirmedTxReceipt(txHash, chainmanagerParams.MainchainTxConfirmations, finalizedEthOpen)
+			if err != nil || receipt == nil {
+				return errors.New("transaction is not confirmed yet. Please wait for sometime and try again")
 			}
 
 			// decode new header block event
-			res, err := contractCallerObj.DecodeNewHeaderBlockEvent(rootChainAddress, receipt, uint64(viper.GetInt64(FlagCheckpointLogIndex)))
+			res, err := adapter.DecodeNewHeaderBlockEvent(receipt, uint64(viper.GetInt64(FlagCheckpointLogIndex)), contracts)
 			if err != nil {
 				return errors.New("invalid transaction for header block")
 			}
@@ -321,3 +319,88 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
 	return cmd
 }
+
+// RelayCheckpointTx submits approved checkpoint waiting for ack to root chain
+func RelayCheckpointTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-checkpoint",
+		Short: "submit approved checkpoint waiting for ack to root chain, any funded account can relay",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rootChain := viper.GetString(FlagRootChain)
+
+			// checkpoint in buffer if header number is not given
+			var headerBlock uint64
+			if headerBlockStr := viper.GetString(FlagHeaderNumber); headerBlockStr != "" {
+				var err error
+				if headerBlock, err = strconv.ParseUint(headerBlockStr, 10, 64); err != nil {
+					return err
+				}
+			}
+
+			payload, err := util.GetCheckpointRelayPayload(cliCtx, rootChain, headerBlock)
+			if err != nil {
+				return err
+			}
+
+			sigs := make([][3]*big.Int, 0, len(payload.Sigs))
+			for _, sig := range payload.Sigs {
+				var parsed [3]*big.Int
+				for i, value := range sig {
+					v, ok := big.NewInt(0).SetString(value, 10)
+					if !ok {
+						return fmt.Errorf("invalid signature value %v", value)
+					}
+					parsed[i] = v
+				}
+				sigs = append(sigs, parsed)
+			}
+
+			// contract comes from chain params of node, not from payload of rest server
+			chainParamsBytes, err := cliCtx.Codec.MarshalJSON(chainmanagerTypes.NewQueryChainParams(rootChain))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryRootChainContracts), chainParamsBytes)
+			if err != nil {
+				return err
+			}
+
+			var contracts helper.RootChainContracts
+			if err := json.Unmarshal(res, &contracts); err != nil {
+				return err
+			}
+
+			if contracts.RootChainAddress == "" {
+				return fmt.Errorf("no root chain contract for %s", rootChain)
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			adapter, err := helper.GetRootChainAdapter(&contractCallerObj, rootChain)
+			if err != nil {
+				return err
+			}
+
+			if err := adapter.SendCheckpoint(common.FromHex(payload.Data), sigs, contracts); err != nil {
+				return err
+			}
+
+			fmt.Printf("Relayed checkpoint %d of %s to %s\n", payload.Number, rootChain, contracts.RootChainAddress)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagHeaderNumber, "", "--header=<header-index>")
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain-type>")
+
+	if err := cmd.MarkFlagRequired(FlagRootChain); err != nil {
+		logger.Error("RelayCheckpointTx | MarkFlagRequired | FlagRootChain", "Error", err)
+	}
+	return cmd
+}
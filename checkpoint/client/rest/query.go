@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,8 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/ethereum/go-ethereum/common"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
@@ -27,6 +30,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 	r.HandleFunc("/checkpoints/buffer/{root}", checkpointBufferHandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/pending/{root}", pendingCheckpointsHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/relay/{root}", checkpointRelayHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/sync/{root}", checkpointSyncBufferHandlerFn(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/count/{root}", checkpointCountHandlerFn(cliCtx)).Methods("GET")
@@ -43,6 +50,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 	r.HandleFunc("/checkpoints/activation-height/{root}", checkpointActivationHeightHandlerFunc(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/{root}/proof/{block}", checkpointProofHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/block/{block}", checkpointByBlockHandlerFunc(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/{root}/{number}", checkpointByNumberHandlerFunc(cliCtx)).Methods("GET")
 }
 
@@ -111,7 +122,7 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-func checkpointSyncBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+func pendingCheckpointsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
@@ -130,6 +141,45 @@ func checkpointSyncBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// get query params
 		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
 		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// fetch checkpoints waiting for ack
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingCheckpoints), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+func checkpointSyncBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get checkpoint number
+		vars := mux.Vars(r)
+		rootChain, ok := vars["root"]
+		if !ok {
+			err := fmt.Errorf("'%s' is not a valid rootChain", vars["root"])
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// staking chain checkpoint is synced to, stake chain if not set
+		targetChain := r.URL.Query().Get("target")
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointSyncParams(rootChain, targetChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
@@ -619,6 +669,98 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// get inclusion proof of a child block against the checkpoint covering it
+func checkpointProofHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		rootChain := vars["root"]
+		if !isValidRootChain(cliCtx, rootChain) {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockProofParams(blockNumber, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query proof
+		res, height, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointProof), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proof found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get checkpoints covering a child block with ack status on each root chain
+func checkpointByBlockHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		// all root chains if not provided
+		rootChain := r.URL.Query().Get("root")
+		if rootChain != "" && !isValidRootChain(cliCtx, rootChain) {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockProofParams(blockNumber, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query checkpoints
+		res, height, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func checkpointListhandlerFn(
 	cliCtx context.CLIContext,
 ) http.HandlerFunc {
@@ -632,7 +774,7 @@ func checkpointListhandlerFn(
 
 		// get page
 		root := vars.Get("root")
-		if hmTypes.GetRootChainID(root) == 0 {
+		if !isValidRootChain(cliCtx, root) {
 			err := fmt.Errorf("valid root chain %v", root)
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -758,3 +900,170 @@ func checkpointActivationHeightHandlerFunc(cliCtx context.CLIContext) http.Handl
 		rest.PostProcessResponse(w, cliCtx, result)
 	}
 }
+
+// checkpointRelayHandlerFn returns signed payload of checkpoint waiting for ack, buffered checkpoint unless number is given
+func checkpointRelayHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		rootChain := vars["root"]
+		if !isValidRootChain(cliCtx, rootChain) {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var number uint64
+		if numberStr := r.URL.Query().Get("number"); numberStr != "" {
+			number, ok = rest.ParseUint64OrReturnBadRequest(w, numberStr)
+			if !ok {
+				return
+			}
+		}
+
+		result, err := getCheckpointRelayPayload(cliCtx, rootChain, number)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// getCheckpointRelayPayload collects side-tx sigs of checkpoint tx along with the root chain contract to submit it to
+func getCheckpointRelayPayload(cliCtx context.CLIContext, rootChain string, number uint64) (*types.CheckpointRelayPayload, error) {
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, rootChain))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointRelay), queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var relayInfo types.CheckpointRelayInfo
+	if err := json.Unmarshal(res, &relayInfo); err != nil {
+		return nil, err
+	}
+
+	txHash, err := getCheckpointTxHash(cliCtx, rootChain, relayInfo.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := helper.QueryTxWithProof(cliCtx, txHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := helper.GetTxDecoder(authTypes.ModuleCdc)
+	stdTx, err := decoder(tx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sideMsg, ok := stdTx.GetMsgs()[0].(hmTypes.SideTxMsg)
+	if !ok {
+		return nil, errors.New("Invalid side-tx msg")
+	}
+
+	// side-tx data
+	sideTxData := sideMsg.GetSideSignBytes()
+
+	// side-tx take 2 blocks to process
+	blockDetails, err := helper.GetBlock(cliCtx, tx.Height+2)
+	if err != nil {
+		return nil, errors.New("Side-tx is not processed yet")
+	}
+
+	sigs, err := helper.GetSideTxSigs(tx.Tx.Hash(), sideTxData, blockDetails.Block.LastCommit.Precommits)
+	if err != nil {
+		return nil, err
+	}
+
+	chainParamsBytes, err := cliCtx.Codec.MarshalJSON(chainmanagerTypes.NewQueryChainParams(rootChain))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryRootChainContracts), chainParamsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var contracts helper.RootChainContracts
+	if err := json.Unmarshal(res, &contracts); err != nil {
+		return nil, err
+	}
+
+	formattedSigs := make([][3]string, 0, len(sigs))
+	for _, sig := range sigs {
+		formattedSigs = append(formattedSigs, [3]string{sig[0].String(), sig[1].String(), sig[2].String()})
+	}
+
+	return &types.CheckpointRelayPayload{
+		RootChain:  rootChain,
+		Number:     relayInfo.Number,
+		Checkpoint: relayInfo.Checkpoint,
+		TxHash:     txHash,
+		Contract:   contracts.RootChainAddress,
+		Method:     types.RelayCheckpointMethod,
+		Data:       hex.EncodeToString(sideTxData),
+		Sigs:       formattedSigs,
+	}, nil
+}
+
+// getCheckpointTxHash resolves tx which proposed checkpoint from tx index,
+// latest one is taken if checkpoint was proposed again after no-ack
+func getCheckpointTxHash(cliCtx context.CLIContext, rootChain string, checkpoint hmTypes.Checkpoint) (hmTypes.HeimdallHash, error) {
+	events := []string{
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeCheckpoint, types.AttributeKeyRootChain, rootChain),
+		fmt.Sprintf("%s.%s='%d'", types.EventTypeCheckpoint, types.AttributeKeyStartBlock, checkpoint.StartBlock),
+		fmt.Sprintf("%s.%s='%d'", types.EventTypeCheckpoint, types.AttributeKeyEndBlock, checkpoint.EndBlock),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeCheckpoint, types.AttributeKeyRootHash, checkpoint.RootHash.String()),
+	}
+
+	searchResult, err := helper.QueryTxsByEvents(cliCtx, events, 1, 1)
+	if err != nil {
+		return hmTypes.HeimdallHash{}, err
+	}
+
+	if searchResult.TotalCount > 1 {
+		if searchResult, err = helper.QueryTxsByEvents(cliCtx, events, searchResult.TotalCount, 1); err != nil {
+			return hmTypes.HeimdallHash{}, err
+		}
+	}
+
+	if len(searchResult.Txs) == 0 {
+		return hmTypes.HeimdallHash{}, errors.New("tx of checkpoint not found")
+	}
+
+	return hmTypes.HexToHeimdallHash(searchResult.Txs[0].TxHash), nil
+}
+
+// isValidRootChain checks if root chain is registered in chain manager
+func isValidRootChain(cliCtx context.CLIContext, rootChain string) bool {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryRootChainIDs), nil)
+	if err != nil {
+		return false
+	}
+
+	var rootChainIDs []chainmanagerTypes.RootChainID
+	if err := json.Unmarshal(res, &rootChainIDs); err != nil {
+		return false
+	}
+
+	for _, rootChainID := range rootChainIDs {
+		if rootChainID.RootChainType == rootChain {
+			return true
+		}
+	}
+
+	return false
+}
@@ -62,6 +62,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 	keeper.UpdateACKCountWithValue(ctx, data.TronAckCount, hmTypes.RootChainTypeTron)
+
+	// queue checkpoints waiting for ack once ack counts are set, first one goes to buffer if it is empty
+	for _, pending := range data.PendingCheckpoints {
+		for _, checkpoint := range pending.Checkpoints {
+			if err := keeper.AddCheckpointToBuffer(ctx, checkpoint, pending.RootChainType); err != nil {
+				keeper.Logger(ctx).Error("InitGenesis | AddCheckpointToBuffer", "root", pending.RootChainType, "error", err)
+			}
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -69,6 +78,22 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
+
+	var pendingCheckpoints []types.RootChainCheckpoints
+	for _, rootChainID := range keeper.ck.GetRootChainIDs(ctx) {
+		checkpoints := keeper.GetPendingCheckpoints(ctx, rootChainID.RootChainType)
+		if rootChainID.RootChainType == hmTypes.RootChainTypeEth && len(checkpoints) != 0 {
+			checkpoints = checkpoints[1:]
+		}
+
+		if len(checkpoints) != 0 {
+			pendingCheckpoints = append(pendingCheckpoints, types.RootChainCheckpoints{
+				RootChainType: rootChainID.RootChainType,
+				Checkpoints:   checkpoints,
+			})
+		}
+	}
+
 	return types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
@@ -77,5 +102,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
 		keeper.GetACKCount(ctx, hmTypes.RootChainTypeTron),
 		hmTypes.SortHeaders(keeper.GetOtherCheckpoints(ctx, hmTypes.RootChainTypeTron)),
+		pendingCheckpoints,
 	)
 }
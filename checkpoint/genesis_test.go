@@ -71,6 +71,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		checkpoints,
 		uint64(ackCount),
 		checkpoints,
+		nil,
 	)
 
 	checkpoint.InitGenesis(ctx, app.CheckpointKeeper, genesisState)
@@ -84,3 +85,30 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.LessOrEqual(t, len(actualParams.Checkpoints), len(genesisState.Checkpoints))
 
 }
+
+func (suite *GenesisTestSuite) TestInitExportPendingCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	proposerAddress := hmTypes.HexToHeimdallAddress("123")
+
+	for _, rootChain := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		for i := uint64(0); i < 3; i++ {
+			checkpoint := hmTypes.CreateBlock(i*256, i*256+255, hmTypes.HexToHeimdallHash("123"), proposerAddress, "1234", 10)
+			require.NoError(t, keeper.AddCheckpointToBuffer(ctx, checkpoint, rootChain))
+		}
+	}
+
+	genesisState := checkpoint.ExportGenesis(ctx, keeper)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+	require.NotNil(t, genesisState.BufferedCheckpoint)
+	require.Len(t, genesisState.PendingCheckpoints, 2)
+
+	newApp, newCtx, _ := createTestApp(true)
+	checkpoint.InitGenesis(newCtx, newApp.CheckpointKeeper, genesisState)
+
+	for _, rootChain := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		expected := keeper.GetPendingCheckpoints(ctx, rootChain)
+		require.Len(t, expected, 3)
+		require.Equal(t, expected, newApp.CheckpointKeeper.GetPendingCheckpoints(newCtx, rootChain))
+	}
+}
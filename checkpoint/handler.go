@@ -38,8 +38,14 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
 	logger := k.Logger(ctx)
 
+	// paused or retired chain doesn't accept new checkpoints
+	if !k.ck.IsChainActive(ctx, msg.RootChainType) {
+		logger.Error("Root chain is not active", "root", msg.RootChainType)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
+
 	timeStamp := uint64(ctx.BlockTime().Unix())
-	params := k.GetParams(ctx)
+	params := k.GetChainParams(ctx, msg.RootChainType)
 
 	//
 	// Check checkpoint buffer
@@ -52,9 +58,9 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint has been timed out. Flushing buffer.", "root", msg.RootChainType, "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", checkpointBuffer.TimeStamp)
 			k.FlushCheckpointBuffer(ctx, msg.RootChainType)
-		} else {
+		} else if pending := k.GetPendingCheckpoints(ctx, msg.RootChainType); uint64(len(pending)) >= params.PendingCheckpointLimit() {
 			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
-			logger.Error("Checkpoint already exits in buffer", "root", msg.RootChainType, "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
+			logger.Error("Checkpoint already exits in buffer", "root", msg.RootChainType, "Checkpoint", checkpointBuffer.String(), "pending", len(pending), "Expires", expiryTime)
 			return common.ErrNoACK(k.Codespace(), expiryTime).Result()
 		}
 	}
@@ -64,6 +70,11 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	//
 	lastCheckpoint, err := k.GetLastCheckpoint(ctx, msg.RootChainType)
 
+	// new checkpoint follows the last one waiting for ack if any
+	if pending := k.GetPendingCheckpoints(ctx, msg.RootChainType); len(pending) != 0 {
+		lastCheckpoint, err = pending[len(pending)-1], nil
+	}
+
 	// fetch last checkpoint from store
 	if err == nil {
 		// make sure new checkpoint is after tip
@@ -158,6 +169,7 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
 			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
 			sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
+			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
 		),
 	})
 
@@ -177,6 +189,12 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 		"start", msg.StartBlock,
 		"end", msg.EndBlock,
 	)
+	// acks land on root chain in order, queued checkpoint can't be acked before the buffered one
+	if k.isQueuedCheckpointNumber(ctx, msg.RootChainType, msg.Number) {
+		logger.Error("Checkpoint ack out of order", "root", msg.RootChainType, "number", msg.Number, "ackCount", k.GetACKCount(ctx, msg.RootChainType))
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+
 	headerBlock, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
 
 	if err == nil {
@@ -220,7 +238,7 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	currentTime := ctx.BlockTime()
 
 	// Get buffer time from params
-	bufferTime := k.GetParams(ctx).CheckpointBufferTime
+	bufferTime := k.GetChainParams(ctx, hmTypes.RootChainTypeStake).CheckpointBufferTime
 
 	// Fetch last checkpoint from store
 	// TODO figure out how to handle this error
@@ -247,6 +265,11 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	k.SetLastNoAck(ctx, newLastNoAck)
 	logger.Debug("Last No-ACK time set", "lastNoAck", newLastNoAck)
 
+	// checkpoints queued behind the buffered ones were proposed by the old proposer
+	for _, rootChainID := range k.ck.GetRootChainIDs(ctx) {
+		k.FlushPendingCheckpoints(ctx, rootChainID.RootChainType)
+	}
+
 	//
 	// Update to new proposer
 	//
@@ -281,24 +304,42 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 // handleMsgCheckpointSync Validates if checkpoint sync submitted on chain is valid
 func handleMsgCheckpointSync(ctx sdk.Context, msg types.MsgCheckpointSync, k Keeper) sdk.Result {
 	logger := k.Logger(ctx)
+	targetChain := msg.TargetChain()
 	k.Logger(ctx).Debug("✅ Validating checkpoint sync msg",
 		"root", msg.RootChainType,
+		"target", targetChain,
 		"number", msg.Number,
 	)
+	if !k.ck.IsChainActive(ctx, msg.RootChainType) {
+		logger.Error("Root chain is not active", "root", msg.RootChainType)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
+	if rootChainID := k.ck.GetRootChainID(ctx, msg.RootChainType); msg.RootChainID != uint64(rootChainID) {
+		logger.Error("Root chain id doesn't match chain manager", "root", msg.RootChainType, "msgRootChainID", msg.RootChainID, "rootChainID", rootChainID)
+		return common.ErrWrongRootChain(k.Codespace()).Result()
+	}
 	timeStamp := uint64(ctx.BlockTime().Unix())
-	params := k.GetParams(ctx)
+	params := k.GetChainParams(ctx, msg.RootChainType)
+	if !params.IsSyncTarget(targetChain) {
+		logger.Error("Target chain doesn't receive checkpoint sync", "target", targetChain)
+		return common.ErrWrongRootChain(k.Codespace()).Result()
+	}
+	if !k.ck.IsChainActive(ctx, targetChain) {
+		logger.Error("Target chain is not active", "target", targetChain)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
 	//
 	// Check checkpoint sync buffer
 	//
-	bufferSync, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType)
+	bufferSync, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType, targetChain)
 	if err == nil {
 		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds() / 5)
 		if bufferSync.TimeStamp == 0 || ((timeStamp > bufferSync.TimeStamp) && timeStamp-bufferSync.TimeStamp >= checkpointBufferTime) {
-			logger.Debug("Checkpoint sync has been timed out. Flushing buffer.", "root", msg.RootChainType)
-			k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType)
+			logger.Debug("Checkpoint sync has been timed out. Flushing buffer.", "root", msg.RootChainType, "target", targetChain)
+			k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType, targetChain)
 		} else {
 			expiryTime := bufferSync.TimeStamp + checkpointBufferTime
-			logger.Error("Checkpoint sync already exits in buffer", "root", msg.RootChainType, "now", timeStamp, "Expires", expiryTime)
+			logger.Error("Checkpoint sync already exits in buffer", "root", msg.RootChainType, "target", targetChain, "now", timeStamp, "Expires", expiryTime)
 			return common.ErrNoACK(k.Codespace(), expiryTime).Result()
 		}
 	}
@@ -321,21 +362,23 @@ func handleMsgCheckpointSync(ctx sdk.Context, msg types.MsgCheckpointSync, k Kee
 // handleMsgCheckpointSyncAck Validates if checkpoint sync submitted on chain is valid
 func handleMsgCheckpointSyncAck(ctx sdk.Context, msg types.MsgCheckpointSyncAck, k Keeper) sdk.Result {
 	logger := k.Logger(ctx)
+	targetChain := msg.TargetChain()
 	k.Logger(ctx).Debug("✅ Validating checkpoint sync ack msg",
 		"root", msg.RootChainType,
+		"target", targetChain,
 		"number", msg.Number,
 	)
 	timeStamp := uint64(ctx.BlockTime().Unix())
-	params := k.GetParams(ctx)
+	params := k.GetChainParams(ctx, msg.RootChainType)
 	//
 	// Check checkpoint sync buffer
 	//
-	bufferSync, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType)
+	bufferSync, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType, targetChain)
 	if err == nil {
 		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds())
 		if bufferSync.TimeStamp == 0 || ((timeStamp > bufferSync.TimeStamp) && timeStamp-bufferSync.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint sync has been timed out. Flushing buffer.", "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", bufferSync.TimeStamp)
-			k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType)
+			k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType, targetChain)
 		}
 	}
 
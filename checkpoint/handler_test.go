@@ -19,6 +19,7 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	tmCommon "github.com/tendermint/tendermint/libs/common"
 )
 
 type HandlerTestSuite struct {
@@ -106,6 +107,10 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpoint() {
 		require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
 		bufferedHeader, _ := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeStake)
 		require.Empty(t, bufferedHeader, "Should not store state")
+
+		// relay finds checkpoint tx in tx index by root chain
+		require.Contains(t, got.Events[len(got.Events)-1].Attributes,
+			tmCommon.KVPair{Key: []byte(types.AttributeKeyRootChain), Value: []byte(hmTypes.RootChainTypeStake)})
 	})
 
 	suite.Run("Invalid Proposer", func() {
@@ -367,6 +372,39 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAck() {
 	require.Equal(t, uint64(0), uint64(ackCount), "Should not update state")
 }
 
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckFlushesQueue() {
+	t, app := suite.T(), suite.app
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, suite.ctx, false, 10)
+	stakingKeeper.IncrementAccum(suite.ctx, 1)
+
+	suite.ctx = suite.ctx.WithBlockTime(time.Now())
+	startBlock := uint64(0)
+	for i := 0; i < 2; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+255,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(suite.ctx.BlockTime().Unix()),
+		)
+		err := keeper.AddCheckpointToBuffer(suite.ctx, checkpoint, hmTypes.RootChainTypeEth)
+		require.NoError(t, err)
+		startBlock += 256
+	}
+
+	result := suite.SendNoAck()
+	require.True(t, result.IsOK(), "expected send-NoAck to be ok, got %v", result)
+
+	// queued checkpoint is dropped, buffered one waits for its ack
+	pending := keeper.GetPendingCheckpoints(suite.ctx, hmTypes.RootChainTypeEth)
+	require.Len(t, pending, 1)
+	require.Equal(t, uint64(0), pending[0].StartBlock)
+}
+
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckBeforeBufferTimeout() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -0,0 +1,79 @@
+package checkpoint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// RegisterInvariants registers all checkpoint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "checkpoints", CheckpointsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the checkpoint module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return CheckpointsInvariant(keeper)(ctx)
+	}
+}
+
+// CheckpointsInvariant checks that every root chain stores exactly ack count checkpoints
+// and that each checkpoint starts right after the end of the previous one
+func CheckpointsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, rootChainID := range keeper.ck.GetRootChainIDs(ctx) {
+			rootChain := rootChainID.RootChainType
+			ackCount := keeper.GetACKCount(ctx, rootChain)
+
+			var storedCount uint64
+
+			iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), GetCheckpointPrefixKey(rootChain, rootChainID.ChainID))
+			for ; iterator.Valid(); iterator.Next() {
+				storedCount++
+			}
+			iterator.Close()
+
+			if storedCount != ackCount {
+				broken = true
+				msg += fmt.Sprintf("\t%s ack count %d but %d checkpoints stored\n", rootChain, ackCount, storedCount)
+			}
+
+			for number := uint64(1); number <= ackCount; number++ {
+				checkpoint, err := keeper.GetCheckpointByNumber(ctx, number, rootChain)
+				if err != nil {
+					broken = true
+					msg += fmt.Sprintf("\t%s checkpoint %d missing\n", rootChain, number)
+
+					break
+				}
+
+				if checkpoint.StartBlock > checkpoint.EndBlock {
+					broken = true
+					msg += fmt.Sprintf("\t%s checkpoint %d has start %d after end %d\n",
+						rootChain, number, checkpoint.StartBlock, checkpoint.EndBlock)
+				}
+
+				if number == 1 {
+					continue
+				}
+
+				prev, err := keeper.GetCheckpointByNumber(ctx, number-1, rootChain)
+				if err == nil && prev.EndBlock+1 != checkpoint.StartBlock {
+					broken = true
+					msg += fmt.Sprintf("\t%s checkpoint %d starts at %d but checkpoint %d ends at %d\n",
+						rootChain, number, checkpoint.StartBlock, number-1, prev.EndBlock)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "checkpoints", msg), broken
+	}
+}
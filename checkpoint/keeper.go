@@ -1,8 +1,10 @@
 package checkpoint
 
 import (
+	"encoding/binary"
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,13 +23,15 @@ var (
 
 	BufferCheckpointSyncKey = []byte{0x02} // Key to store checkpoint in buffer
 
-	ACKCountKey         = []byte{0x11} // key to store ACK count
-	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
-	EthCheckpointKey    = []byte{0x13} // prefix key for when storing checkpoint after ACK
-	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+	ACKCountKey          = []byte{0x11} // key to store ACK count
+	BufferCheckpointKey  = []byte{0x12} // Key to store checkpoint in buffer
+	EthCheckpointKey     = []byte{0x13} // prefix key for when storing checkpoint after ACK
+	LastNoACKKey         = []byte{0x14} // key to store last no-ack
+	PendingCheckpointKey = []byte{0x15} // prefix key for checkpoints queued behind checkpoint in buffer
 
-	TronCheckpointKey = []byte{0x21} // prefix key for when storing checkpoint after ACK
-	BscCheckpointKey  = []byte{0x22} // prefix key for when storing checkpoint after ACK
+	TronCheckpointKey      = []byte{0x21} // prefix key for when storing checkpoint after ACK
+	BscCheckpointKey       = []byte{0x22} // prefix key for when storing checkpoint after ACK
+	RootChainCheckpointKey = []byte{0x23} // prefix key for when storing checkpoint of chains added by chain manager
 
 )
 
@@ -66,12 +70,30 @@ func NewKeeper(
 	keeper := Keeper{
 		cdc:                cdc,
 		storeKey:           storeKey,
-		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:          codespace,
 		sk:                 stakingKeeper,
 		ck:                 chainKeeper,
 		moduleCommunicator: moduleCommunicator,
 	}
+
+	// param changes by governance are checked together with rest of current params
+	keyTable := types.ParamKeyTable()
+	for _, key := range [][]byte{
+		types.KeyCheckpointBufferTime,
+		types.KeyAvgCheckpointLength,
+		types.KeyMaxCheckpointLength,
+		types.KeyChildBlockInterval,
+		types.KeyChainCheckpointParams,
+		types.KeySyncTargetChains,
+		types.KeyMaxPendingCheckpoints,
+	} {
+		key := key
+		keyTable = keyTable.RegisterValidator(key, func(ctx sdk.Context, value interface{}) error {
+			return keeper.validateParamChange(ctx, key, value)
+		})
+	}
+	keeper.paramSpace = paramSpace.WithKeyTable(keyTable)
+
 	return keeper
 }
 
@@ -87,7 +109,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // AddCheckpoint adds checkpoint into final blocks
 func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpoint hmTypes.Checkpoint, rootChain string) error {
-	key := GetCheckpointKey(checkpointNumber, rootChain)
+	key := GetCheckpointKey(checkpointNumber, rootChain, k.ck.GetRootChainID(ctx, rootChain))
 	err := k.addCheckpoint(ctx, key, checkpoint)
 	if err != nil {
 		return err
@@ -103,7 +125,7 @@ func getCheckpointBufferKey(rootID byte) []byte {
 
 // SetCheckpointBuffer set Checkpoint Buffer
 func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string) error {
-	key := getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointBufferKey(k.ck.GetRootChainID(ctx, rootChain))
 	err := k.addCheckpoint(ctx, key, checkpoint)
 	if err != nil {
 		return err
@@ -132,7 +154,7 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.C
 func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64, rootChain string) (hmTypes.Checkpoint, error) {
 	store := ctx.KVStore(k.storeKey)
 	var _checkpoint hmTypes.Checkpoint
-	checkpointKey := GetCheckpointKey(number, rootChain)
+	checkpointKey := GetCheckpointKey(number, rootChain, k.ck.GetRootChainID(ctx, rootChain))
 
 	if store.Has(checkpointKey) {
 		err := k.cdc.UnmarshalBinaryBare(store.Get(checkpointKey), &_checkpoint)
@@ -158,13 +180,7 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64, r
 	}
 
 	// get paginated iterator
-	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, EthCheckpointKey, uint(page), uint(limit))
-	switch rootChain {
-	case hmTypes.RootChainTypeTron:
-		iterator = hmTypes.KVStorePrefixIteratorPaginated(store, TronCheckpointKey, uint(page), uint(limit))
-	case hmTypes.RootChainTypeBsc:
-		iterator = hmTypes.KVStorePrefixIteratorPaginated(store, BscCheckpointKey, uint(page), uint(limit))
-	}
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, GetCheckpointPrefixKey(rootChain, k.ck.GetRootChainID(ctx, rootChain)), uint(page), uint(limit))
 
 	// loop through validators to get valid validators
 	for ; iterator.Valid(); iterator.Next() {
@@ -189,7 +205,7 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context, rootChain string) (hmTypes.C
 
 	// no checkpoint received
 	// header key
-	headerKey := GetCheckpointKey(lastCheckpointKey, rootChain)
+	headerKey := GetCheckpointKey(lastCheckpointKey, rootChain, k.ck.GetRootChainID(ctx, rootChain))
 	if store.Has(headerKey) {
 		err := k.cdc.UnmarshalBinaryBare(store.Get(headerKey), &_checkpoint)
 		if err != nil {
@@ -203,19 +219,77 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context, rootChain string) (hmTypes.C
 	return _checkpoint, cmn.ErrNoCheckpointFound(k.Codespace())
 }
 
-// GetCheckpointKey appends prefix to checkpointNumber
-func GetCheckpointKey(checkpointNumber uint64, rootChain string) []byte {
-	var key []byte
+// GetCheckpointByBlockNumber returns the acked checkpoint covering given child block along with its number
+func (k *Keeper) GetCheckpointByBlockNumber(ctx sdk.Context, blockNumber uint64, rootChain string) (uint64, hmTypes.Checkpoint, error) {
+	// checkpoints are contiguous and ordered by number, binary search over them
+	low, high := uint64(1), k.GetACKCount(ctx, rootChain)
+	for low <= high {
+		mid := low + (high-low)/2
+		checkpoint, err := k.GetCheckpointByNumber(ctx, mid, rootChain)
+		if err != nil {
+			return 0, checkpoint, err
+		}
+
+		switch {
+		case blockNumber < checkpoint.StartBlock:
+			high = mid - 1
+		case blockNumber > checkpoint.EndBlock:
+			low = mid + 1
+		default:
+			return mid, checkpoint, nil
+		}
+	}
+
+	return 0, hmTypes.Checkpoint{}, cmn.ErrNoCheckpointFound(k.Codespace())
+}
+
+// GetBlockCheckpointStatus returns whether child block is covered by an acked or buffered checkpoint of root chain
+func (k *Keeper) GetBlockCheckpointStatus(ctx sdk.Context, blockNumber uint64, rootChain string) types.BlockCheckpointStatus {
+	status := types.BlockCheckpointStatus{
+		RootChain: rootChain,
+		Status:    types.BlockStatusPending,
+	}
+
+	if number, checkpoint, err := k.GetCheckpointByBlockNumber(ctx, blockNumber, rootChain); err == nil {
+		status.Status = types.BlockStatusAcked
+		status.CheckpointNumber = number
+		status.Checkpoint = &checkpoint
+
+		return status
+	}
+
+	// checkpoints waiting for ack get acked in order
+	for i, checkpoint := range k.GetPendingCheckpoints(ctx, rootChain) {
+		if blockNumber >= checkpoint.StartBlock && blockNumber <= checkpoint.EndBlock {
+			checkpoint := checkpoint
+			status.Status = types.BlockStatusBuffered
+			status.CheckpointNumber = k.GetACKCount(ctx, rootChain) + uint64(i) + 1
+			status.Checkpoint = &checkpoint
+
+			break
+		}
+	}
+
+	return status
+}
+
+// GetCheckpointPrefixKey returns prefix of acked checkpoints for root chain
+func GetCheckpointPrefixKey(rootChain string, rootChainID byte) []byte {
 	switch rootChain {
 	case hmTypes.RootChainTypeEth:
-		key = EthCheckpointKey
+		return EthCheckpointKey
 	case hmTypes.RootChainTypeTron:
-		key = TronCheckpointKey
+		return TronCheckpointKey
 	case hmTypes.RootChainTypeBsc:
-		key = BscCheckpointKey
+		return BscCheckpointKey
 	}
+	return append(append([]byte{}, RootChainCheckpointKey...), rootChainID)
+}
+
+// GetCheckpointKey appends prefix to checkpointNumber
+func GetCheckpointKey(checkpointNumber uint64, rootChain string, rootChainID byte) []byte {
 	checkpointNumberBytes := []byte(strconv.FormatUint(checkpointNumber, 10))
-	return append(key, checkpointNumberBytes...)
+	return append(GetCheckpointPrefixKey(rootChain, rootChainID), checkpointNumberBytes...)
 }
 
 // HasStoreValue check if value exists in store or not
@@ -224,10 +298,112 @@ func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 	return store.Has(key)
 }
 
-// FlushCheckpointBuffer flushes Checkpoint Buffer
+// FlushCheckpointBuffer flushes Checkpoint Buffer along with checkpoints queued behind it
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context, rootChain string) {
 	store := ctx.KVStore(k.storeKey)
-	key := getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointBufferKey(k.ck.GetRootChainID(ctx, rootChain))
+	store.Delete(key)
+
+	k.FlushPendingCheckpoints(ctx, rootChain)
+}
+
+// getPendingCheckpointPrefixKey returns prefix of checkpoints queued behind checkpoint in buffer
+func getPendingCheckpointPrefixKey(rootID byte) []byte {
+	return append(append([]byte{}, PendingCheckpointKey...), rootID)
+}
+
+// getPendingCheckpointKey returns key of queued checkpoint by the number it gets once acked
+func getPendingCheckpointKey(rootID byte, number uint64) []byte {
+	numberBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(numberBytes, number)
+	return append(getPendingCheckpointPrefixKey(rootID), numberBytes...)
+}
+
+// GetPendingCheckpoints returns checkpoints waiting for ack in order, checkpoint in buffer first
+func (k *Keeper) GetPendingCheckpoints(ctx sdk.Context, rootChain string) []hmTypes.Checkpoint {
+	buffered, err := k.GetCheckpointFromBuffer(ctx, rootChain)
+	if err != nil {
+		return nil
+	}
+
+	checkpoints := []hmTypes.Checkpoint{*buffered}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), getPendingCheckpointPrefixKey(k.ck.GetRootChainID(ctx, rootChain)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpoint hmTypes.Checkpoint
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &checkpoint); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling pending checkpoint", "root", rootChain, "error", err)
+			break
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	return checkpoints
+}
+
+// AddCheckpointToBuffer queues checkpoint behind pending ones, it goes to buffer if nothing is pending
+func (k *Keeper) AddCheckpointToBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string) error {
+	pending := k.GetPendingCheckpoints(ctx, rootChain)
+	if len(pending) == 0 {
+		return k.SetCheckpointBuffer(ctx, checkpoint, rootChain)
+	}
+
+	number := k.GetACKCount(ctx, rootChain) + uint64(len(pending)) + 1
+	return k.addCheckpoint(ctx, getPendingCheckpointKey(k.ck.GetRootChainID(ctx, rootChain), number), checkpoint)
+}
+
+// FlushPendingCheckpoints flushes checkpoints queued behind checkpoint in buffer
+func (k *Keeper) FlushPendingCheckpoints(ctx sdk.Context, rootChain string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, getPendingCheckpointPrefixKey(k.ck.GetRootChainID(ctx, rootChain)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// isQueuedCheckpointNumber returns true if number belongs to a checkpoint queued behind checkpoint in buffer
+func (k *Keeper) isQueuedCheckpointNumber(ctx sdk.Context, rootChain string, number uint64) bool {
+	ackCount := k.GetACKCount(ctx, rootChain)
+	pending := k.GetPendingCheckpoints(ctx, rootChain)
+
+	return number > ackCount+1 && number <= ackCount+uint64(len(pending))
+}
+
+// ShiftCheckpointBuffer replaces acked checkpoint in buffer with the next queued one,
+// ack count must already include the acked checkpoint
+func (k *Keeper) ShiftCheckpointBuffer(ctx sdk.Context, rootChain string) {
+	store := ctx.KVStore(k.storeKey)
+	rootID := k.ck.GetRootChainID(ctx, rootChain)
+	store.Delete(getCheckpointBufferKey(rootID))
+
+	key := getPendingCheckpointKey(rootID, k.GetACKCount(ctx, rootChain)+1)
+	if !store.Has(key) {
+		return
+	}
+
+	var checkpoint hmTypes.Checkpoint
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &checkpoint); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling pending checkpoint", "root", rootChain, "error", err)
+		return
+	}
+
+	// buffer time of queued checkpoint starts once it can be submitted
+	checkpoint.TimeStamp = uint64(ctx.BlockTime().Unix())
+	if err := k.SetCheckpointBuffer(ctx, checkpoint, rootChain); err != nil {
+		k.Logger(ctx).Error("Error moving pending checkpoint to buffer", "root", rootChain, "error", err)
+		return
+	}
+
 	store.Delete(key)
 }
 
@@ -237,7 +413,7 @@ func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context, rootChain string) (*hm
 
 	// checkpoint block header
 	var checkpoint hmTypes.Checkpoint
-	key := getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointBufferKey(k.ck.GetRootChainID(ctx, rootChain))
 
 	if store.Has(key) {
 		// Get checkpoint and unmarshall
@@ -248,15 +424,21 @@ func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context, rootChain string) (*hm
 	return nil, errors.New("No checkpoint found in buffer")
 }
 
-func getCheckpointSyncKey(rootID byte) []byte {
-	return append(BufferCheckpointSyncKey, rootID)
+// getCheckpointSyncKey returns sync buffer key of root chain checkpoints synced to target chain,
+// stake chain target keeps the key used before sync targets were configurable
+func (k *Keeper) getCheckpointSyncKey(ctx sdk.Context, rootChain string, targetChain string) []byte {
+	rootID := k.ck.GetRootChainID(ctx, rootChain)
+	if targetChain == hmTypes.RootChainTypeStake {
+		return append(BufferCheckpointSyncKey, rootID)
+	}
+	return append(BufferCheckpointSyncKey, rootID, k.ck.GetRootChainID(ctx, targetChain))
 }
 
 // CheckpointSyncBuffer set Checkpoint sync Buffer
-func (k *Keeper) SetCheckpointSyncBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string) error {
+func (k *Keeper) SetCheckpointSyncBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string, targetChain string) error {
 	store := ctx.KVStore(k.storeKey)
 
-	key := getCheckpointSyncKey(hmTypes.GetRootChainID(rootChain))
+	key := k.getCheckpointSyncKey(ctx, rootChain, targetChain)
 
 	// create Checkpoint sync and marshall
 	out, err := k.cdc.MarshalBinaryBare(checkpoint)
@@ -271,10 +453,10 @@ func (k *Keeper) SetCheckpointSyncBuffer(ctx sdk.Context, checkpoint hmTypes.Che
 }
 
 // GetCheckpointSyncFromBuffer gets checkpoint sync in buffer
-func (k *Keeper) GetCheckpointSyncFromBuffer(ctx sdk.Context, rootChain string) (*hmTypes.Checkpoint, error) {
+func (k *Keeper) GetCheckpointSyncFromBuffer(ctx sdk.Context, rootChain string, targetChain string) (*hmTypes.Checkpoint, error) {
 	store := ctx.KVStore(k.storeKey)
 
-	key := getCheckpointSyncKey(hmTypes.GetRootChainID(rootChain))
+	key := k.getCheckpointSyncKey(ctx, rootChain, targetChain)
 	// checkpoint block header
 	if store.Has(key) {
 		var checkpoint hmTypes.Checkpoint
@@ -287,10 +469,10 @@ func (k *Keeper) GetCheckpointSyncFromBuffer(ctx sdk.Context, rootChain string)
 }
 
 // FlushCheckpointSyncBuffer flushes Checkpoint sync Buffer
-func (k *Keeper) FlushCheckpointSyncBuffer(ctx sdk.Context, rootChain string) {
+func (k *Keeper) FlushCheckpointSyncBuffer(ctx sdk.Context, rootChain string, targetChain string) {
 	store := ctx.KVStore(k.storeKey)
 
-	key := getCheckpointSyncKey(hmTypes.GetRootChainID(rootChain))
+	key := k.getCheckpointSyncKey(ctx, rootChain, targetChain)
 	store.Delete(key)
 }
 
@@ -370,7 +552,7 @@ func GetAckCountKey(rootID byte) []byte {
 // GetACKCount returns current ACK count
 func (k Keeper) GetACKCount(ctx sdk.Context, rootChain string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	key := GetAckCountKey(hmTypes.GetRootChainID(rootChain))
+	key := GetAckCountKey(k.ck.GetRootChainID(ctx, rootChain))
 	// checkpoint block header
 	if store.Has(key) {
 		// check if ack count is there
@@ -393,7 +575,7 @@ func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64, rootChain
 	ackCount := []byte(strconv.FormatUint(value, 10))
 
 	// update
-	key := GetAckCountKey(hmTypes.GetRootChainID(rootChain))
+	key := GetAckCountKey(k.ck.GetRootChainID(ctx, rootChain))
 	store.Set(key, ackCount)
 }
 
@@ -407,7 +589,7 @@ func (k Keeper) UpdateACKCount(ctx sdk.Context, rootChain string) {
 	// increment by 1
 	ACKs := []byte(strconv.FormatUint(ACKCount+1, 10))
 	// update
-	key := GetAckCountKey(hmTypes.GetRootChainID(rootChain))
+	key := GetAckCountKey(k.ck.GetRootChainID(ctx, rootChain))
 	store.Set(key, ACKs)
 
 }
@@ -425,3 +607,32 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return
 }
+
+// validateParamChange validates params with changed value of key applied
+func (k Keeper) validateParamChange(ctx sdk.Context, key []byte, value interface{}) error {
+	params := k.GetParams(ctx)
+
+	switch string(key) {
+	case string(types.KeyCheckpointBufferTime):
+		params.CheckpointBufferTime = *value.(*time.Duration)
+	case string(types.KeyAvgCheckpointLength):
+		params.AvgCheckpointLength = *value.(*uint64)
+	case string(types.KeyMaxCheckpointLength):
+		params.MaxCheckpointLength = *value.(*uint64)
+	case string(types.KeyChildBlockInterval):
+		params.ChildBlockInterval = *value.(*uint64)
+	case string(types.KeyChainCheckpointParams):
+		params.ChainParams = *value.(*[]types.ChainCheckpointParams)
+	case string(types.KeySyncTargetChains):
+		params.SyncTargetChains = *value.(*[]string)
+	case string(types.KeyMaxPendingCheckpoints):
+		params.MaxPendingCheckpoints = *value.(*uint64)
+	}
+
+	return params.Validate()
+}
+
+// GetChainParams gets checkpoint params with overrides of root chain applied
+func (k Keeper) GetChainParams(ctx sdk.Context, rootChain string) types.Params {
+	return k.GetParams(ctx).ForRootChain(rootChain)
+}
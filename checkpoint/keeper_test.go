@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
 	"github.com/stretchr/testify/require"
@@ -114,3 +115,204 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestPendingCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeBsc
+
+	require.Empty(t, keeper.GetPendingCheckpoints(ctx, rootChain))
+
+	startBlock := uint64(0)
+	for i := 0; i < 3; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+255,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpointToBuffer(ctx, checkpoint, rootChain)
+		require.NoError(t, err)
+		startBlock += 256
+	}
+
+	pending := keeper.GetPendingCheckpoints(ctx, rootChain)
+	require.Len(t, pending, 3)
+	require.Equal(t, uint64(0), pending[0].StartBlock)
+	require.Equal(t, uint64(512), pending[2].StartBlock)
+
+	buffered, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.NoError(t, err)
+	require.Equal(t, pending[0], *buffered)
+
+	// ack of buffered checkpoint moves next queued one to buffer
+	err = keeper.AddCheckpoint(ctx, 1, *buffered, rootChain)
+	require.NoError(t, err)
+	keeper.UpdateACKCount(ctx, rootChain)
+	keeper.ShiftCheckpointBuffer(ctx, rootChain)
+
+	pending = keeper.GetPendingCheckpoints(ctx, rootChain)
+	require.Len(t, pending, 2)
+	require.Equal(t, uint64(256), pending[0].StartBlock)
+	require.Equal(t, uint64(512), pending[1].StartBlock)
+
+	// tail flush keeps buffered checkpoint
+	keeper.FlushPendingCheckpoints(ctx, rootChain)
+	pending = keeper.GetPendingCheckpoints(ctx, rootChain)
+	require.Len(t, pending, 1)
+	require.Equal(t, uint64(256), pending[0].StartBlock)
+
+	keeper.FlushCheckpointBuffer(ctx, rootChain)
+	require.Empty(t, keeper.GetPendingCheckpoints(ctx, rootChain))
+}
+
+func (suite *KeeperTestSuite) TestGetChainParams() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.ChainParams = []checkpointTypes.ChainCheckpointParams{
+		{
+			RootChainType:        hmTypes.RootChainTypeTron,
+			CheckpointBufferTime: 100 * time.Second,
+			MaxCheckpointLength:  2048,
+		},
+	}
+	keeper.SetParams(ctx, params)
+
+	// tron overrides buffer time and max length, keeps global avg length and interval
+	tronParams := keeper.GetChainParams(ctx, hmTypes.RootChainTypeTron)
+	require.Equal(t, 100*time.Second, tronParams.CheckpointBufferTime)
+	require.Equal(t, uint64(2048), tronParams.MaxCheckpointLength)
+	require.Equal(t, params.AvgCheckpointLength, tronParams.AvgCheckpointLength)
+	require.Equal(t, params.ChildBlockInterval, tronParams.ChildBlockInterval)
+
+	// chain without overrides uses global params
+	ethParams := keeper.GetChainParams(ctx, hmTypes.RootChainTypeEth)
+	require.Equal(t, params.CheckpointBufferTime, ethParams.CheckpointBufferTime)
+	require.Equal(t, params.MaxCheckpointLength, ethParams.MaxCheckpointLength)
+
+	// duplicate overrides are rejected
+	params.ChainParams = append(params.ChainParams, checkpointTypes.ChainCheckpointParams{RootChainType: hmTypes.RootChainTypeTron})
+	require.Error(t, params.Validate())
+}
+
+func (suite *KeeperTestSuite) TestParamChangeValidation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	subspace, ok := app.ParamsKeeper.GetSubspace(checkpointTypes.DefaultParamspace)
+	require.True(t, ok)
+
+	// overrides are validated against global params on param change
+	err := subspace.Update(ctx, checkpointTypes.KeyChainCheckpointParams,
+		[]byte(`[{"root_chain_type":"tron","avg_checkpoint_length":"4096"}]`))
+	require.Error(t, err)
+
+	err = subspace.Update(ctx, checkpointTypes.KeyChainCheckpointParams,
+		[]byte(`[{"root_chain_type":"tron","max_checkpoint_length":"2048"},{"root_chain_type":"tron"}]`))
+	require.Error(t, err)
+	require.Empty(t, keeper.GetParams(ctx).ChainParams)
+
+	err = subspace.Update(ctx, checkpointTypes.KeyChainCheckpointParams,
+		[]byte(`[{"root_chain_type":"tron","max_checkpoint_length":"2048"}]`))
+	require.NoError(t, err)
+	require.Len(t, keeper.GetParams(ctx).ChainParams, 1)
+
+	// global params are validated against overrides in store
+	err = subspace.Update(ctx, checkpointTypes.KeyAvgCheckpointLength, []byte(`"4096"`))
+	require.Error(t, err)
+
+	err = subspace.Update(ctx, checkpointTypes.KeySyncTargetChains, []byte(`["tron","tron"]`))
+	require.Error(t, err)
+
+	err = subspace.Update(ctx, checkpointTypes.KeyMaxPendingCheckpoints, []byte(`"4"`))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), keeper.GetParams(ctx).PendingCheckpointLimit())
+}
+
+func (suite *KeeperTestSuite) TestCheckpointSyncBufferPerTarget() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	checkpoint := hmTypes.Checkpoint{StartBlock: 0, EndBlock: 255, TimeStamp: 10}
+
+	err := keeper.SetCheckpointSyncBuffer(ctx, checkpoint, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+
+	// eth checkpoint synced to stake chain doesn't occupy bsc target buffer
+	_, err = keeper.GetCheckpointSyncFromBuffer(ctx, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc)
+	require.Error(t, err)
+
+	err = keeper.SetCheckpointSyncBuffer(ctx, checkpoint, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc)
+	require.NoError(t, err)
+
+	keeper.FlushCheckpointSyncBuffer(ctx, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeStake)
+	_, err = keeper.GetCheckpointSyncFromBuffer(ctx, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeStake)
+	require.Error(t, err)
+
+	res, err := keeper.GetCheckpointSyncFromBuffer(ctx, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint.EndBlock, res.EndBlock)
+
+	// stake chain is the only sync target unless configured
+	params := keeper.GetParams(ctx)
+	require.True(t, params.IsSyncTarget(hmTypes.RootChainTypeStake))
+	require.False(t, params.IsSyncTarget(hmTypes.RootChainTypeBsc))
+
+	params.SyncTargetChains = []string{hmTypes.RootChainTypeTron, hmTypes.RootChainTypeBsc}
+	keeper.SetParams(ctx, params)
+	require.True(t, keeper.GetParams(ctx).IsSyncTarget(hmTypes.RootChainTypeBsc))
+}
+
+func (suite *KeeperTestSuite) TestCheckpointsInvariant() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	startBlock := uint64(0)
+	for i := uint64(1); i <= 3; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+255,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpoint(ctx, i, checkpoint, hmTypes.RootChainTypeBsc)
+		require.NoError(t, err)
+		keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeBsc)
+
+		startBlock += 256
+	}
+
+	_, broken := checkpoint.CheckpointsInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// ack count ahead of stored checkpoints
+	keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeBsc)
+
+	msg, broken := checkpoint.CheckpointsInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "bsc ack count 4 but 3 checkpoints stored")
+
+	// gap between checkpoints
+	keeper.UpdateACKCountWithValue(ctx, 3, hmTypes.RootChainTypeBsc)
+
+	gapCheckpoint := hmTypes.CreateBlock(
+		startBlock+10,
+		startBlock+255,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 4, gapCheckpoint, hmTypes.RootChainTypeBsc))
+	keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeBsc)
+
+	msg, broken = checkpoint.CheckpointsInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "bsc checkpoint 4 starts at 778 but checkpoint 3 ends at 767")
+}
@@ -1,6 +1,7 @@
 package checkpoint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -28,6 +29,10 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryCheckpoint(ctx, req, keeper)
 		case types.QueryCheckpointBuffer:
 			return handleQueryCheckpointBuffer(ctx, req, keeper)
+		case types.QueryPendingCheckpoints:
+			return handleQueryPendingCheckpoints(ctx, req, keeper)
+		case types.QueryCheckpointRelay:
+			return handleQueryCheckpointRelay(ctx, req, keeper)
 		case types.QueryCheckpointSyncBuffer:
 			return handleQueryCheckpointSyncBuffer(ctx, req, keeper)
 		case types.QueryLastNoAck:
@@ -38,6 +43,10 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
 		case types.QueryCheckpointActivation:
 			return handleQueryCheckpointActivation(ctx, req, keeper)
+		case types.QueryCheckpointProof:
+			return handleQueryCheckpointProof(ctx, req, keeper, contractCaller)
+		case types.QueryCheckpointByBlock:
+			return handleQueryCheckpointByBlock(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -134,13 +143,67 @@ func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+func handleQueryPendingCheckpoints(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res := keeper.GetPendingCheckpoints(ctx, params.RootChain)
+	if res == nil {
+		res = []hmTypes.Checkpoint{}
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointRelay(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	pending := keeper.GetPendingCheckpoints(ctx, params.RootChain)
+	if len(pending) == 0 {
+		return nil, common.ErrNoCheckpointBufferFound(keeper.Codespace())
+	}
+
+	// checkpoint in buffer unless number is given
+	ackCount := keeper.GetACKCount(ctx, params.RootChain)
+	number := ackCount + 1
+	if params.Number != 0 {
+		number = params.Number
+	}
+
+	if number <= ackCount || number > ackCount+uint64(len(pending)) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("checkpoint %d is not waiting for ack", number))
+	}
+
+	bz, err := json.Marshal(types.CheckpointRelayInfo{
+		Number:     number,
+		Checkpoint: pending[number-ackCount-1],
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointSyncBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryCheckpointParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointSyncFromBuffer(ctx, params.RootChain)
+	if params.TargetChain == "" {
+		params.TargetChain = hmTypes.RootChainTypeStake
+	}
+
+	res, err := keeper.GetCheckpointSyncFromBuffer(ctx, params.RootChain, params.TargetChain)
 
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint buffer", err.Error()))
@@ -196,7 +259,7 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	validatorSet := sk.GetValidatorSet(ctx)
 	proposer := validatorSet.GetProposer()
 	ackCount := keeper.GetACKCount(ctx, hmTypes.RootChainTypeStake)
-	params := keeper.GetParams(ctx)
+	params := keeper.GetChainParams(ctx, hmTypes.RootChainTypeStake)
 
 	var start uint64
 
@@ -254,3 +317,86 @@ func handleQueryCheckpointActivation(ctx sdk.Context, req abci.RequestQuery, kee
 	}
 	return bz, nil
 }
+
+func handleQueryCheckpointProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryBlockProofParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	// find checkpoint covering the block
+	number, checkpoint, err := keeper.GetCheckpointByBlockNumber(ctx, params.BlockNumber, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(
+			fmt.Sprintf("could not find checkpoint for block %v %v", params.BlockNumber, params.RootChain), err.Error()))
+	}
+
+	headers, err := contractCaller.GetMaticChainBlockHeaders(checkpoint.StartBlock, checkpoint.EndBlock, keeper.GetChainParams(ctx, params.RootChain).MaxCheckpointLength)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(
+			fmt.Sprintf("could not fetch headers for start:%v end:%v", checkpoint.StartBlock, checkpoint.EndBlock), err.Error()))
+	}
+
+	proof, root, index, err := types.GetBlockProof(headers, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not generate block proof", err.Error()))
+	}
+
+	// make sure proof is built against committed root hash
+	if !bytes.Equal(root, checkpoint.RootHash.Bytes()) {
+		return nil, sdk.ErrInternal(fmt.Sprintf("computed root hash %v does not match checkpoint %v root hash %v",
+			hmTypes.BytesToHeimdallHash(root), number, checkpoint.RootHash))
+	}
+
+	res := types.BlockProof{
+		RootChain:        params.RootChain,
+		CheckpointNumber: number,
+		Checkpoint:       checkpoint,
+		BlockNumber:      params.BlockNumber,
+		LeafIndex:        index,
+		Leaf:             types.GetBlockHeaderLeaf(headers[index]),
+		Proof:            proof,
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointByBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBlockProofParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// all root chains unless one is asked for
+	var rootChains []string
+	if params.RootChain != "" {
+		rootChains = append(rootChains, params.RootChain)
+	} else {
+		for _, rootChainID := range keeper.ck.GetRootChainIDs(ctx) {
+			rootChains = append(rootChains, rootChainID.RootChainType)
+		}
+	}
+
+	res := types.BlockCheckpoints{
+		BlockNumber: params.BlockNumber,
+		RootChains:  make([]types.BlockCheckpointStatus, 0, len(rootChains)),
+	}
+
+	for _, rootChain := range rootChains {
+		res.RootChains = append(res.RootChains, keeper.GetBlockCheckpointStatus(ctx, params.BlockNumber, rootChain))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
@@ -307,3 +309,140 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, actualRes.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, actualRes.BorChainID)
 }
+
+func (suite *QuerierTestSuite) TestQueryCheckpointProof() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	startBlock := uint64(256)
+	endBlock := uint64(260)
+	blockNumber := uint64(258)
+
+	headers := make([]*ethTypes.Header, 0, endBlock-startBlock+1)
+	for i := startBlock; i <= endBlock; i++ {
+		headers = append(headers, &ethTypes.Header{
+			Number:      big.NewInt(int64(i)),
+			Time:        i * 2,
+			TxHash:      ethCommon.BigToHash(big.NewInt(int64(i))),
+			ReceiptHash: ethCommon.BigToHash(big.NewInt(int64(i * 3))),
+		})
+	}
+
+	_, rootHash, _, err := types.GetBlockProof(headers, startBlock)
+	require.NoError(t, err)
+
+	// previous checkpoint followed by the one covering the block
+	app.CheckpointKeeper.AddCheckpoint(ctx, 1, hmTypes.CreateBlock(0, startBlock-1, hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix())), hmTypes.RootChainTypeStake)
+	checkpointBlock := hmTypes.CreateBlock(startBlock, endBlock, hmTypes.BytesToHeimdallHash(rootHash),
+		hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix()))
+	app.CheckpointKeeper.AddCheckpoint(ctx, 2, checkpointBlock, hmTypes.RootChainTypeStake)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 2, hmTypes.RootChainTypeStake)
+
+	suite.contractCaller.On("GetMaticChainBlockHeaders", startBlock, endBlock, uint64(1024)).Return(headers, nil)
+
+	path := []string{types.QueryCheckpointProof}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointProof)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryBlockProofParams(blockNumber, hmTypes.RootChainTypeStake)),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var blockProof types.BlockProof
+	require.NoError(t, json.Unmarshal(res, &blockProof))
+
+	require.Equal(t, uint64(2), blockProof.CheckpointNumber)
+	require.Equal(t, checkpointBlock, blockProof.Checkpoint)
+	require.Equal(t, blockNumber-startBlock, blockProof.LeafIndex)
+	require.True(t, types.VerifyBlockProof(blockProof.Leaf, blockProof.LeafIndex, blockProof.Proof, checkpointBlock.RootHash.Bytes()))
+
+	// block not yet checkpointed
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBlockProofParams(endBlock+1, hmTypes.RootChainTypeStake))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointByBlock() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	// acked on tron, buffered on eth
+	acked := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix()))
+	app.CheckpointKeeper.AddCheckpoint(ctx, 1, acked, hmTypes.RootChainTypeTron)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1, hmTypes.RootChainTypeTron)
+
+	buffered := hmTypes.CreateBlock(0, 511, hmTypes.HexToHeimdallHash("456"),
+		hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix()))
+	require.NoError(t, app.CheckpointKeeper.SetCheckpointBuffer(ctx, buffered, hmTypes.RootChainTypeEth))
+
+	path := []string{types.QueryCheckpointByBlock}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryBlockProofParams(100, "")),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var blockCheckpoints types.BlockCheckpoints
+	require.NoError(t, json.Unmarshal(res, &blockCheckpoints))
+	require.Equal(t, uint64(100), blockCheckpoints.BlockNumber)
+
+	statuses := make(map[string]types.BlockCheckpointStatus)
+	for _, status := range blockCheckpoints.RootChains {
+		statuses[status.RootChain] = status
+	}
+
+	require.Equal(t, types.BlockStatusAcked, statuses[hmTypes.RootChainTypeTron].Status)
+	require.Equal(t, uint64(1), statuses[hmTypes.RootChainTypeTron].CheckpointNumber)
+	require.Equal(t, acked, *statuses[hmTypes.RootChainTypeTron].Checkpoint)
+
+	require.Equal(t, types.BlockStatusBuffered, statuses[hmTypes.RootChainTypeEth].Status)
+	require.Equal(t, uint64(1), statuses[hmTypes.RootChainTypeEth].CheckpointNumber)
+
+	require.Equal(t, types.BlockStatusPending, statuses[hmTypes.RootChainTypeBsc].Status)
+	require.Nil(t, statuses[hmTypes.RootChainTypeBsc].Checkpoint)
+
+	// single root chain
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBlockProofParams(300, hmTypes.RootChainTypeTron))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &blockCheckpoints))
+	require.Len(t, blockCheckpoints.RootChains, 1)
+	require.Equal(t, types.BlockStatusPending, blockCheckpoints.RootChains[0].Status)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointRelay() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryCheckpointRelay}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointRelay)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(0, hmTypes.RootChainTypeEth)),
+	}
+
+	// nothing in buffer
+	_, err := querier(ctx, path, req)
+	require.Error(t, err)
+
+	buffered := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix()))
+	require.NoError(t, app.CheckpointKeeper.AddCheckpointToBuffer(ctx, buffered, hmTypes.RootChainTypeEth))
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var relayInfo types.CheckpointRelayInfo
+	require.NoError(t, json.Unmarshal(res, &relayInfo))
+	require.Equal(t, uint64(1), relayInfo.Number)
+	require.Equal(t, buffered, relayInfo.Checkpoint)
+
+	// checkpoint not waiting for ack
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(2, hmTypes.RootChainTypeEth))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+}
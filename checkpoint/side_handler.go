@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	ethCommon "github.com/ethereum/go-ethereum/common"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 
@@ -40,7 +39,7 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 // SideHandleMsgCheckpoint handles MsgCheckpoint message for external call
 func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
 	// get params
-	params := k.GetParams(ctx)
+	params := k.GetChainParams(ctx, msg.RootChainType)
 	maticTxConfirmations := k.ck.GetParams(ctx).MaticchainTxConfirmations
 
 	// logger
@@ -72,10 +71,6 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 
 // SideHandleMsgCheckpointAck handles MsgCheckpointAck message for external call
 func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
-	if msg.RootChainType == hmTypes.RootChainTypeTron {
-		return SideHandleMsgTronCheckpointAck(ctx, k, msg, contractCaller)
-	}
-
 	logger := k.Logger(ctx)
 	logger.Debug("✅ Validating External call for checkpoint ack msg",
 		"root", msg.RootChainType,
@@ -84,33 +79,26 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		"number", msg.Number,
 	)
 
-	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	params := k.GetChainParams(ctx, msg.RootChainType)
 
-	//
-	// Validate data from root chain
-	//
-	var rootChainAddress ethCommon.Address
-	switch msg.RootChainType {
-	case hmTypes.RootChainTypeEth:
-		rootChainAddress = chainParams.RootChainAddress.EthAddress()
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
-		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, "does not match bsc")
-			return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
-		}
-		rootChainAddress = bscChain.RootChainAddress.EthAddress()
+	adapter, err := helper.GetRootChainAdapter(contractCaller, msg.RootChainType)
+	if err != nil {
+		logger.Error("Unable to fetch root chain adapter", "root", msg.RootChainType, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
-	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress, msg.RootChainType)
+
+	contracts, err := k.ck.GetRootChainContracts(ctx, msg.RootChainType)
 	if err != nil {
-		logger.Error("Unable to fetch rootchain contract instance", "error", err)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		logger.Error("Unable to fetch root chain contracts", "root", msg.RootChainType, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
 
-	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.Number, rootChainInstance, params.ChildBlockInterval)
+	//
+	// Validate data from root chain
+	//
+	root, start, end, _, proposer, err := adapter.GetHeaderInfo(msg.Number, contracts, params.ChildBlockInterval)
 	if err != nil {
-		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number)
+		logger.Error("Unable to fetch checkpoint from rootchain", "root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
 
@@ -130,40 +118,6 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	return
 }
 
-// SideHandleMsgTronCheckpointAck handles MsgCheckpointAck message for external call
-func SideHandleMsgTronCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
-	logger := k.Logger(ctx)
-
-	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
-
-	//
-	// Validate data from root chain
-	//
-	if msg.RootChainType == hmTypes.RootChainTypeTron {
-		root, start, end, _, proposer, err := contractCaller.GetTronHeaderInfo(msg.Number, chainParams.TronChainAddress, params.ChildBlockInterval)
-		if err != nil {
-			logger.Error("Unable to fetch checkpoint from tron", "error", err, "checkpointNumber", msg.Number)
-			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
-		}
-
-		// check if message data matches with contract data
-		if msg.StartBlock != start ||
-			msg.EndBlock != end ||
-			!msg.Proposer.Equals(proposer) ||
-			!bytes.Equal(msg.RootHash.Bytes(), root.Bytes()) {
-
-			logger.Error("Invalid message. It doesn't match with contract state", "error", err, "checkpointNumber", msg.Number)
-			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
-		}
-		// say `yes`
-		result.Result = abci.SideTxResultType_Yes
-		return
-	}
-
-	return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
-}
-
 // SideHandleMsgCheckpointSync handles MsgCheckpointSync message for external call
 func SideHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpointSync, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
 	// logger
@@ -173,36 +127,24 @@ func SideHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 		"number", msg.Number,
 	)
 
-	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
-
-	//
-	// Validate data from root chain
-	//
-	var (
-		start, end uint64
-		proposer   hmTypes.HeimdallAddress
-		err        error
-	)
+	params := k.GetChainParams(ctx, msg.RootChainType)
 
-	var rootChainAddress ethCommon.Address
-	switch msg.RootChainType {
-	case hmTypes.RootChainTypeEth:
-		rootChainAddress = chainParams.RootChainAddress.EthAddress()
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
-		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, "does not  match bsc")
-			return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
-		}
-		rootChainAddress = bscChain.RootChainAddress.EthAddress()
+	adapter, err := helper.GetRootChainAdapter(contractCaller, msg.RootChainType)
+	if err != nil {
+		logger.Error("Unable to fetch root chain adapter", "root", msg.RootChainType, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
-	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress, msg.RootChainType)
+
+	contracts, err := k.ck.GetRootChainContracts(ctx, msg.RootChainType)
 	if err != nil {
-		logger.Error("Unable to fetch rootchain contract instance", "root", msg.RootChainType, "error", err)
-		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
+		logger.Error("Unable to fetch root chain contracts", "root", msg.RootChainType, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
-	_, start, end, _, proposer, err = contractCaller.GetHeaderInfo(msg.Number, rootChainInstance, params.ChildBlockInterval)
+
+	//
+	// Validate data from root chain
+	//
+	_, start, end, _, proposer, err := adapter.GetHeaderInfo(msg.Number, contracts, params.ChildBlockInterval)
 	if err != nil {
 		logger.Error("Unable to fetch checkpoint from rootchain",
 			"root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
@@ -228,23 +170,36 @@ func SideHandleMsgCheckpointSyncAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 	// logger
 	logger := k.Logger(ctx)
 
+	targetChain := msg.TargetChain()
 	logger.Debug("✅ Validating External call for checkpoint sync ack msg",
 		"root", msg.RootChainType,
+		"target", targetChain,
 		"number", msg.Number,
 	)
 
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	// synced checkpoint is stored on stake manager of target chain
+	adapter, err := helper.GetRootChainAdapter(contractCaller, targetChain)
+	if err != nil {
+		logger.Error("Unable to fetch root chain adapter", "target", targetChain, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
+
+	contracts, err := k.ck.GetRootChainContracts(ctx, targetChain)
+	if err != nil {
+		logger.Error("Unable to fetch root chain contracts", "target", targetChain, "error", err)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
 
 	//
 	// Validate data from root chain
 	//
-	currentNumber, err := contractCaller.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress, msg.RootChainType)
+	currentNumber, err := adapter.GetSyncedCheckpointID(k.ck.GetRootChainID(ctx, msg.RootChainType), contracts)
 	if err != nil {
-		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number)
+		logger.Error("Unable to fetch checkpoint from rootchain", "target", targetChain, "error", err, "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
 	if msg.Number > currentNumber {
-		logger.Error("Invalid message. It doesn't match with contract state", "error", err, "checkpointNumber", msg.Number)
+		logger.Error("Invalid message. It doesn't match with contract state", "target", targetChain, "error", err, "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
 
@@ -288,11 +243,37 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
+	// chain may be paused or retired while checkpoint was voted
+	if !k.ck.IsChainActive(ctx, msg.RootChainType) {
+		logger.Error("Root chain is not active", "root", msg.RootChainType)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
+
+	//
+	// Check checkpoint buffer
+	//
+	pending := k.GetPendingCheckpoints(ctx, msg.RootChainType)
+	params := k.GetChainParams(ctx, msg.RootChainType)
+	if uint64(len(pending)) >= params.PendingCheckpointLimit() {
+		logger.Debug("Checkpoint already exists in buffer", "pending", len(pending))
+
+		// get checkpoint buffer time from params
+		expiryTime := pending[0].TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
+
+		// return with error (ack is required)
+		return common.ErrNoACK(k.Codespace(), expiryTime).Result()
+	}
+
 	//
 	// Validate last checkpoint
 	//
 	lastCheckpoint, err := k.GetLastCheckpoint(ctx, msg.RootChainType)
 
+	// new checkpoint follows the last one waiting for ack if any
+	if len(pending) != 0 {
+		lastCheckpoint, err = pending[len(pending)-1], nil
+	}
+
 	// fetch last checkpoint from store
 	if err == nil {
 		// make sure new checkpoint is after tip
@@ -324,41 +305,40 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	//
 	// Save checkpoint to buffer store
 	//
-	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
-	if err == nil && checkpointBuffer != nil {
-		logger.Debug("Checkpoint already exists in buffer")
-
-		// get checkpoint buffer time from params
-		params := k.GetParams(ctx)
-		expiryTime := checkpointBuffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
-
-		// return with error (ack is required)
-		return common.ErrNoACK(k.Codespace(), expiryTime).Result()
-	}
-
 	timeStamp := uint64(ctx.BlockTime().Unix())
+	number := k.GetACKCount(ctx, msg.RootChainType) + uint64(len(pending)) + 1
 
-	// Add checkpoint to buffer with root hash and account hash
-	k.SetCheckpointBuffer(ctx, hmTypes.Checkpoint{
+	// Add checkpoint to buffer with root hash and account hash, behind checkpoints waiting for ack
+	if err := k.AddCheckpointToBuffer(ctx, hmTypes.Checkpoint{
 		StartBlock: msg.StartBlock,
 		EndBlock:   msg.EndBlock,
 		RootHash:   msg.RootHash,
 		Proposer:   msg.Proposer,
 		BorChainID: msg.BorChainID,
 		TimeStamp:  timeStamp,
-	}, msg.RootChainType)
+	}, msg.RootChainType); err != nil {
+		logger.Error("Error while adding checkpoint into buffer", "number", number, "root", msg.RootChainType, "error", err)
+		return sdk.ErrInternal("Failed to add checkpoint into buffer").Result()
+	}
+
+	// remember proposal height to look up validator set which signed checkpoint
+	if err := k.sk.RecordCheckpointProposal(ctx, msg.RootChainType, number); err != nil {
+		logger.Error("Error while recording checkpoint proposal", "number", number, "root", msg.RootChainType, "error", err)
+		return sdk.ErrInternal("Failed to record checkpoint proposal").Result()
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
 
 	logger.Debug("New checkpoint into buffer stored",
+		"number", number,
 		"startBlock", msg.StartBlock,
 		"endBlock", msg.EndBlock,
 		"rootHash", msg.RootHash,
 		"rootChain", msg.RootChainType,
 	)
 
-	// TX bytes
-	txBytes := ctx.TxBytes()
-	hash := tmTypes.Tx(txBytes).Hash()
-
 	// Emit event for checkpoints
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -373,6 +353,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
 			sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
 			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(number, 10)),
 		),
 	})
 
@@ -392,6 +373,12 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
+	// acks land on root chain in order, queued checkpoint can't be acked before the buffered one
+	if k.isQueuedCheckpointNumber(ctx, msg.RootChainType, msg.Number) {
+		logger.Error("Checkpoint ack out of order", "checkpointNumber", msg.Number, "root", msg.RootChainType)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+
 	// get last checkpoint from buffer
 	checkpointObj, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
 	if err != nil {
@@ -429,6 +416,9 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		checkpointObj.EndBlock = msg.EndBlock
 		checkpointObj.RootHash = msg.RootHash
 		checkpointObj.Proposer = msg.Proposer
+
+		// queued checkpoints no longer follow the acked one
+		k.FlushPendingCheckpoints(ctx, msg.RootChainType)
 	}
 
 	//
@@ -445,7 +435,7 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 
 	// Flush buffer
 	k.UpdateACKCount(ctx, msg.RootChainType)
-	k.FlushCheckpointBuffer(ctx, msg.RootChainType)
+	k.ShiftCheckpointBuffer(ctx, msg.RootChainType)
 
 	logger.Debug("Checkpoint buffer flushed after receiving checkpoint ack", "root", msg.RootChainType)
 
@@ -499,16 +489,27 @@ func PostHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
+	if !k.ck.IsChainActive(ctx, msg.RootChainType) {
+		logger.Error("Root chain is not active", "root", msg.RootChainType)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
+
+	targetChain := msg.TargetChain()
+	if !k.ck.IsChainActive(ctx, targetChain) {
+		logger.Error("Target chain is not active", "target", targetChain)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
+
 	//
 	// Save checkpoint to buffer store
 	//
-	checkpointSyncBuffer, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType)
+	checkpointSyncBuffer, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType, targetChain)
 
 	if err == nil && checkpointSyncBuffer != nil {
 		logger.Debug("Checkpoint sync already exists in buffer")
 
 		// get checkpoint buffer time from params
-		params := k.GetParams(ctx)
+		params := k.GetChainParams(ctx, msg.RootChainType)
 		expiryTime := checkpointSyncBuffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
 
 		// return with error (ack is required)
@@ -522,10 +523,11 @@ func PostHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 		EndBlock:   msg.EndBlock,
 		Proposer:   msg.Proposer,
 		TimeStamp:  timeStamp,
-	}, msg.RootChainType)
+	}, msg.RootChainType, targetChain)
 
 	logger.Debug("New checkpoint sync into buffer stored",
 		"rootChain", msg.RootChainType,
+		"targetChain", targetChain,
 		"startBlock", msg.StartBlock,
 		"endBlock", msg.EndBlock,
 		"proposer", msg.Proposer,
@@ -548,6 +550,7 @@ func PostHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
 			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
 			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyTargetChain, targetChain),
 			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
 		),
 	})
@@ -571,8 +574,9 @@ func PostHandleMsgCheckpointSyncAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 	//
 	// Update checkpoint sync state
 	//
-	k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType)
-	logger.Debug("Checkpoint buffer flushed after receiving checkpoint sync ack", "root", msg.RootChainType)
+	targetChain := msg.TargetChain()
+	k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType, targetChain)
+	logger.Debug("Checkpoint buffer flushed after receiving checkpoint sync ack", "root", msg.RootChainType, "target", targetChain)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -588,6 +592,7 @@ func PostHandleMsgCheckpointSyncAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
 			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
 			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+			sdk.NewAttribute(types.AttributeKeyTargetChain, targetChain),
 		),
 	})
 
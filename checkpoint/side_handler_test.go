@@ -297,6 +297,10 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpoint() {
 		require.Equal(t, bufferedHeader.Proposer, header.Proposer)
 		require.Equal(t, bufferedHeader.BorChainID, header.BorChainID)
 		require.Empty(t, err, "Unable to set checkpoint from buffer, Error: %v", err)
+
+		proposal, found := stakingKeeper.GetCheckpointProposal(ctx, hmTypes.RootChainTypeEth, 1)
+		require.True(t, found)
+		require.Equal(t, ctx.BlockHeight(), proposal.Height)
 	})
 
 	suite.Run("Replay", func() {
@@ -444,3 +448,83 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 		require.Nil(t, afterAckBufferedCheckpoint)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointPipelined() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.MaxPendingCheckpoints = 2
+	keeper.SetParams(ctx, params)
+
+	// generate proposer for validator set
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	maxSize := uint64(256)
+	header1, _ := chSim.GenRandCheckpoint(0, maxSize, params.MaxCheckpointLength)
+	header2, _ := chSim.GenRandCheckpoint(header1.EndBlock+1, maxSize, params.MaxCheckpointLength)
+	header3, _ := chSim.GenRandCheckpoint(header2.EndBlock+1, maxSize, params.MaxCheckpointLength)
+
+	newCheckpoint := func(header hmTypes.Checkpoint) types.MsgCheckpoint {
+		return types.NewMsgCheckpointBlock(
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			header.RootHash,
+			"1234",
+			1,
+			hmTypes.RootChainTypeEth,
+		)
+	}
+	newCheckpointAck := func(number uint64, header hmTypes.Checkpoint) types.MsgCheckpointAck {
+		return types.NewMsgCheckpointAck(
+			hmTypes.HexToHeimdallAddress("123"),
+			number,
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToHeimdallHash("123123"),
+			uint64(1),
+			hmTypes.RootChainTypeEth,
+		)
+	}
+
+	suite.Run("Queue", func() {
+		result := suite.postHandler(ctx, newCheckpoint(header1), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+		result = suite.postHandler(ctx, newCheckpoint(header2), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected queued checkpoint to be ok, got %v", result)
+
+		// queue is full
+		result = suite.postHandler(ctx, newCheckpoint(header3), abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK())
+		require.Equal(t, common.CodeNoACK, result.Code)
+
+		require.Len(t, keeper.GetPendingCheckpoints(ctx, hmTypes.RootChainTypeEth), 2)
+	})
+
+	suite.Run("OutOfOrderAck", func() {
+		result := suite.postHandler(ctx, newCheckpointAck(2, header2), abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK())
+		require.Equal(t, common.CodeInvalidACK, result.Code)
+	})
+
+	suite.Run("Ack", func() {
+		result := suite.postHandler(ctx, newCheckpointAck(1, header1), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+
+		bufferedCheckpoint, err := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
+		require.NoError(t, err)
+		require.Equal(t, header2.StartBlock, bufferedCheckpoint.StartBlock)
+		require.Equal(t, header2.EndBlock, bufferedCheckpoint.EndBlock)
+
+		result = suite.postHandler(ctx, newCheckpointAck(2, header2), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+		require.Empty(t, keeper.GetPendingCheckpoints(ctx, hmTypes.RootChainTypeEth))
+		require.Equal(t, uint64(2), keeper.GetACKCount(ctx, hmTypes.RootChainTypeEth))
+	})
+}
@@ -44,6 +44,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		Checkpoints,
 		uint64(ackCount),
 		Checkpoints,
+		nil,
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 
@@ -1,14 +1,17 @@
 package simulation
 
 import (
+	"math/rand"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/simulation"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,3 +50,22 @@ func LoadValidatorSet(count int, t *testing.T, keeper staking.Keeper, ctx sdk.Co
 	require.NotNil(t, vals)
 	return valSet
 }
+
+// LoadAccountValidators loads validators with power of 100 signing with deterministic accounts,
+// validator ids start from 1 in order of accounts
+func LoadAccountValidators(count int, t *testing.T, keeper staking.Keeper, ctx sdk.Context) ([]simulation.Account, []*types.Validator) {
+	accounts := simulation.RandomAccounts(rand.New(rand.NewSource(1)), count)
+	validators := make([]*types.Validator, 0, count)
+
+	for i, account := range accounts {
+		pubKey := account.PubKey.(secp256k1.PubKeySecp256k1)
+		validator := types.NewValidator(types.NewValidatorID(uint64(i+1)), 0, 0, 1, 100, types.NewPubKey(pubKey[:]), account.Address)
+
+		err := keeper.AddValidator(ctx, *validator)
+		require.NoError(t, err, "Unable to set validator, Error: %v", err)
+
+		validators = append(validators, validator)
+	}
+
+	return accounts, validators
+}
@@ -16,6 +16,7 @@ var (
 	AttributeKeyRootHash    = "root-hash"
 	AttributeKeyAccountHash = "account-hash"
 	AttributeKeyRootChain   = "root-chain"
+	AttributeKeyTargetChain = "target-chain"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -18,6 +19,15 @@ type GenesisState struct {
 	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
 	TronAckCount       uint64               `json:"tron_ack_count" yaml:"tron_ack_count"`
 	TronCheckpoints    []hmTypes.Checkpoint `json:"tron_checkpoints" yaml:"tron_checkpoints"`
+
+	// checkpoints waiting for ack of every root chain in order, buffered checkpoint of eth is kept in BufferedCheckpoint
+	PendingCheckpoints []RootChainCheckpoints `json:"pending_checkpoints,omitempty" yaml:"pending_checkpoints,omitempty"`
+}
+
+// RootChainCheckpoints checkpoints of root chain, in order of their numbers
+type RootChainCheckpoints struct {
+	RootChainType string               `json:"root_chain_type" yaml:"root_chain_type"`
+	Checkpoints   []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -29,6 +39,7 @@ func NewGenesisState(
 	checkpoints []hmTypes.Checkpoint,
 	tronAckCount uint64,
 	tronCheckpoints []hmTypes.Checkpoint,
+	pendingCheckpoints []RootChainCheckpoints,
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
@@ -38,6 +49,7 @@ func NewGenesisState(
 		Checkpoints:        checkpoints,
 		TronAckCount:       tronAckCount,
 		TronCheckpoints:    tronCheckpoints,
+		PendingCheckpoints: pendingCheckpoints,
 	}
 }
 
@@ -61,6 +73,18 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	seen := make(map[string]bool, len(data.PendingCheckpoints))
+	for _, pending := range data.PendingCheckpoints {
+		if seen[pending.RootChainType] {
+			return fmt.Errorf("duplicate pending checkpoints of %s", pending.RootChainType)
+		}
+		seen[pending.RootChainType] = true
+
+		if pending.RootChainType == hmTypes.RootChainTypeEth && data.BufferedCheckpoint == nil {
+			return errors.New("pending checkpoints of eth are set without buffered checkpoint")
+		}
+	}
+
 	return nil
 }
 
@@ -3,9 +3,12 @@ package types
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	"github.com/cbergoon/merkletree"
 	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/tendermint/crypto/sha3"
 	"golang.org/x/sync/errgroup"
@@ -34,6 +37,71 @@ func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash,
 	return false, nil
 }
 
+// GetBlockHeaderLeaf returns the checkpoint merkle tree leaf of a bor block header
+func GetBlockHeaderLeaf(header *ethTypes.Header) [32]byte {
+	var leaf [32]byte
+	copy(leaf[:], crypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	)))
+	return leaf
+}
+
+// GetBlockProof returns the inclusion proof of a block in the checkpoint tree built from headers,
+// along with the tree root and the leaf index of the block
+func GetBlockProof(headers []*ethTypes.Header, blockNumber uint64) (proof []byte, root []byte, index uint64, err error) {
+	leaves := make([][32]byte, nextPowerOfTwo(uint64(len(headers))))
+	found := false
+	for i, header := range headers {
+		leaves[i] = GetBlockHeaderLeaf(header)
+		if header.Number.Uint64() == blockNumber {
+			index = uint64(i)
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, nil, 0, errors.New("block not found in headers")
+	}
+
+	// walk up the tree collecting siblings
+	layer := convert(leaves)
+	position := index
+	for len(layer) > 1 {
+		proof = append(proof, layer[position^1]...)
+
+		next := make([][]byte, len(layer)/2)
+		for i := 0; i < len(layer); i += 2 {
+			next[i/2] = crypto.Keccak256(layer[i], layer[i+1])
+		}
+		layer = next
+		position /= 2
+	}
+
+	return proof, layer[0], index, nil
+}
+
+// VerifyBlockProof checks inclusion proof of leaf at index against root
+func VerifyBlockProof(leaf [32]byte, index uint64, proof []byte, root []byte) bool {
+	if len(proof)%32 != 0 {
+		return false
+	}
+
+	hash := leaf[:]
+	for i := 0; i < len(proof); i += 32 {
+		if index%2 == 0 {
+			hash = crypto.Keccak256(hash, proof[i:i+32])
+		} else {
+			hash = crypto.Keccak256(proof[i:i+32], hash)
+		}
+		index /= 2
+	}
+
+	return bytes.Equal(hash, root)
+}
+
 // GetAccountRootHash returns roothash of Validator Account State Tree
 func GetAccountRootHash(dividendAccounts []hmTypes.DividendAccount) ([]byte, error) {
 	tree, err := GetAccountTree(dividendAccounts)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"errors"
 	"math/big"
 	"strconv"
 
@@ -106,6 +107,42 @@ func (msg MsgCheckpoint) GetSideSignBytes() []byte {
 	)
 }
 
+// DecodeCheckpointSideSignBytes decodes checkpoint from side sign bytes of MsgCheckpoint, root chain type is not part of them
+func DecodeCheckpointSideSignBytes(data []byte) (MsgCheckpoint, error) {
+	if len(data) != 7*32 {
+		return MsgCheckpoint{}, errors.New("invalid checkpoint side sign bytes length")
+	}
+
+	word := func(i int) []byte {
+		return data[i*32 : (i+1)*32]
+	}
+
+	// proposer is left padded address
+	if !bytes.Equal(word(0)[:12], make([]byte, 12)) {
+		return MsgCheckpoint{}, errors.New("invalid checkpoint proposer")
+	}
+
+	numbers := make([]uint64, 7)
+	for _, i := range []int{1, 2, 5, 6} {
+		number := new(big.Int).SetBytes(word(i))
+		if !number.IsUint64() {
+			return MsgCheckpoint{}, errors.New("invalid checkpoint number")
+		}
+
+		numbers[i] = number.Uint64()
+	}
+
+	return MsgCheckpoint{
+		Proposer:        types.BytesToHeimdallAddress(word(0)[12:]),
+		StartBlock:      numbers[1],
+		EndBlock:        numbers[2],
+		RootHash:        types.BytesToHeimdallHash(word(3)),
+		AccountRootHash: types.BytesToHeimdallHash(word(4)),
+		BorChainID:      strconv.FormatUint(numbers[5], 10),
+		Epoch:           numbers[6],
+	}, nil
+}
+
 //
 // Msg Checkpoint Ack
 //
@@ -261,17 +298,36 @@ type MsgCheckpointSync struct {
 	StartBlock    uint64                `json:"start_block"`
 	EndBlock      uint64                `json:"end_block"`
 	RootChainType string                `json:"root_chain_type"`
+
+	// staking chain checkpoint is synced to, stake chain if empty
+	TargetChainType string `json:"target_chain_type,omitempty"`
+
+	// chain manager id of root chain
+	RootChainID uint64 `json:"root_chain_id,omitempty"`
 }
 
-func NewMsgCheckpointSync(from, proposer types.HeimdallAddress, number, start, end uint64, rootChain string) MsgCheckpointSync {
-	return MsgCheckpointSync{
+func NewMsgCheckpointSync(from, proposer types.HeimdallAddress, number, start, end uint64, rootChain string, rootChainID byte, targetChain string) MsgCheckpointSync {
+	msg := MsgCheckpointSync{
 		From:          from,
 		Number:        number,
 		Proposer:      proposer,
 		StartBlock:    start,
 		EndBlock:      end,
 		RootChainType: rootChain,
+		RootChainID:   uint64(rootChainID),
+	}
+	if targetChain != types.RootChainTypeStake {
+		msg.TargetChainType = targetChain
+	}
+	return msg
+}
+
+// TargetChain returns staking chain checkpoint is synced to
+func (msg MsgCheckpointSync) TargetChain() string {
+	if msg.TargetChainType == "" {
+		return types.RootChainTypeStake
 	}
+	return msg.TargetChainType
 }
 
 func (msg MsgCheckpointSync) Type() string {
@@ -298,6 +354,12 @@ func (msg MsgCheckpointSync) ValidateBasic() sdk.Error {
 	if msg.Proposer.Empty() {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.Proposer.String())
 	}
+	if msg.TargetChain() == msg.RootChainType {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Checkpoint sync target should differ from root chain %v", msg.RootChainType)
+	}
+	if msg.RootChainID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain id %v", msg.RootChainID)
+	}
 	return nil
 }
 
@@ -309,7 +371,7 @@ func (msg MsgCheckpointSync) GetSideSignBytes() []byte {
 		new(big.Int).SetUint64(msg.StartBlock).Bytes(),
 		new(big.Int).SetUint64(msg.EndBlock).Bytes(),
 		new(big.Int).SetUint64(msg.Number).Bytes(),
-		new(big.Int).SetUint64(uint64(types.GetRootChainID(msg.RootChainType))).Bytes(),
+		new(big.Int).SetUint64(msg.RootChainID).Bytes(),
 	)
 }
 
@@ -321,14 +383,23 @@ var _ sdk.Msg = &MsgCheckpointSyncAck{}
 
 type MsgCheckpointSyncAck MsgCheckpointSync
 
-func NewMsgCheckpointSyncAck(proposer types.HeimdallAddress, number, start, end uint64, rootChain string) MsgCheckpointSyncAck {
-	return MsgCheckpointSyncAck{
+func NewMsgCheckpointSyncAck(proposer types.HeimdallAddress, number, start, end uint64, rootChain string, targetChain string) MsgCheckpointSyncAck {
+	msg := MsgCheckpointSyncAck{
 		Number:        number,
 		Proposer:      proposer,
 		StartBlock:    start,
 		EndBlock:      end,
 		RootChainType: rootChain,
 	}
+	if targetChain != types.RootChainTypeStake {
+		msg.TargetChainType = targetChain
+	}
+	return msg
+}
+
+// TargetChain returns staking chain checkpoint is synced to
+func (msg MsgCheckpointSyncAck) TargetChain() string {
+	return MsgCheckpointSync(msg).TargetChain()
 }
 
 func (msg MsgCheckpointSyncAck) Type() string {
@@ -355,6 +426,9 @@ func (msg MsgCheckpointSyncAck) ValidateBasic() sdk.Error {
 	if msg.Proposer.Empty() {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.Proposer.String())
 	}
+	if msg.TargetChain() == msg.RootChainType {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Checkpoint sync target should differ from root chain %v", msg.RootChainType)
+	}
 	return nil
 }
 
@@ -7,14 +7,16 @@ import (
 	"time"
 
 	"github.com/maticnetwork/heimdall/params/subspace"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // Default parameter values
 const (
-	DefaultCheckpointBufferTime time.Duration = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
-	DefaultAvgCheckpointLength  uint64        = 256
-	DefaultMaxCheckpointLength  uint64        = 1024
-	DefaultChildBlockInterval   uint64        = 10000
+	DefaultCheckpointBufferTime  time.Duration = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
+	DefaultAvgCheckpointLength   uint64        = 256
+	DefaultMaxCheckpointLength   uint64        = 1024
+	DefaultChildBlockInterval    uint64        = 10000
+	DefaultMaxPendingCheckpoints uint64        = 1
 )
 
 // Parameter keys
@@ -23,9 +25,13 @@ var (
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
 	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+
+	KeyChainCheckpointParams = []byte("ChainCheckpointParams")
+	KeySyncTargetChains      = []byte("SyncTargetChains")
+	KeyMaxPendingCheckpoints = []byte("MaxPendingCheckpoints")
 )
 
-var _ subspace.ParamSet = &Params{}
+var _ subspace.OptionalParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
@@ -33,6 +39,23 @@ type Params struct {
 	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
 	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+
+	// per root chain overrides of values above
+	ChainParams []ChainCheckpointParams `json:"chain_params,omitempty" yaml:"chain_params,omitempty"`
+	// staking root chains receiving checkpoints of other root chains, stake chain if empty
+	SyncTargetChains []string `json:"sync_target_chains,omitempty" yaml:"sync_target_chains,omitempty"`
+	// checkpoints allowed to wait for ack per root chain, one if zero
+	MaxPendingCheckpoints uint64 `json:"max_pending_checkpoints,omitempty" yaml:"max_pending_checkpoints,omitempty"`
+}
+
+// ChainCheckpointParams overrides checkpoint params for a root chain, zero values fall back to global params
+type ChainCheckpointParams struct {
+	RootChainType         string        `json:"root_chain_type" yaml:"root_chain_type"`
+	CheckpointBufferTime  time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
+	AvgCheckpointLength   uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
+	MaxCheckpointLength   uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
+	ChildBlockInterval    uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+	MaxPendingCheckpoints uint64        `json:"max_pending_checkpoints,omitempty" yaml:"max_pending_checkpoints,omitempty"`
 }
 
 // NewParams creates a new Params object
@@ -52,7 +75,8 @@ func NewParams(
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().
+		RegisterParamSet(&Params{})
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -67,6 +91,15 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	}
 }
 
+// OptionalParamSetPairs implements the OptionalParamSet interface and returns params added after chain start.
+func (p *Params) OptionalParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyChainCheckpointParams, Value: &p.ChainParams},
+		{Key: KeySyncTargetChains, Value: &p.SyncTargetChains},
+		{Key: KeyMaxPendingCheckpoints, Value: &p.MaxPendingCheckpoints},
+	}
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
@@ -92,9 +125,68 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
 	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("MaxPendingCheckpoints: %d\n", p.PendingCheckpointLimit()))
+	for _, cp := range p.ChainParams {
+		sb.WriteString(fmt.Sprintf("ChainParams[%s]: CheckpointBufferTime: %s, AvgCheckpointLength: %d, MaxCheckpointLength: %d, ChildBlockInterval: %d, MaxPendingCheckpoints: %d\n",
+			cp.RootChainType, cp.CheckpointBufferTime, cp.AvgCheckpointLength, cp.MaxCheckpointLength, cp.ChildBlockInterval, cp.MaxPendingCheckpoints))
+	}
+	sb.WriteString(fmt.Sprintf("SyncTargetChains: %v\n", p.SyncTargets()))
 	return sb.String()
 }
 
+// ForRootChain returns params with overrides of root chain applied
+func (p Params) ForRootChain(rootChain string) Params {
+	res := p
+	for _, cp := range p.ChainParams {
+		if cp.RootChainType != rootChain {
+			continue
+		}
+
+		if cp.CheckpointBufferTime != 0 {
+			res.CheckpointBufferTime = cp.CheckpointBufferTime
+		}
+		if cp.AvgCheckpointLength != 0 {
+			res.AvgCheckpointLength = cp.AvgCheckpointLength
+		}
+		if cp.MaxCheckpointLength != 0 {
+			res.MaxCheckpointLength = cp.MaxCheckpointLength
+		}
+		if cp.ChildBlockInterval != 0 {
+			res.ChildBlockInterval = cp.ChildBlockInterval
+		}
+		if cp.MaxPendingCheckpoints != 0 {
+			res.MaxPendingCheckpoints = cp.MaxPendingCheckpoints
+		}
+	}
+	return res
+}
+
+// PendingCheckpointLimit returns number of checkpoints allowed to wait for ack per root chain
+func (p Params) PendingCheckpointLimit() uint64 {
+	if p.MaxPendingCheckpoints == 0 {
+		return DefaultMaxPendingCheckpoints
+	}
+	return p.MaxPendingCheckpoints
+}
+
+// SyncTargets returns root chains checkpoint syncs are submitted to
+func (p Params) SyncTargets() []string {
+	if len(p.SyncTargetChains) == 0 {
+		return []string{hmTypes.RootChainTypeStake}
+	}
+	return p.SyncTargetChains
+}
+
+// IsSyncTarget returns true if checkpoint syncs are submitted to root chain
+func (p Params) IsSyncTarget(rootChain string) bool {
+	for _, target := range p.SyncTargets() {
+		if target == rootChain {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if p.MaxCheckpointLength == 0 || p.AvgCheckpointLength == 0 {
@@ -109,5 +201,51 @@ func (p Params) Validate() error {
 		return fmt.Errorf("ChildBlockInterval should be greater than zero")
 	}
 
+	if err := ValidateChainCheckpointParams(p, p.ChainParams); err != nil {
+		return err
+	}
+
+	return ValidateSyncTargetChains(p.SyncTargetChains)
+}
+
+// ValidateChainCheckpointParams checks overrides are unique per root chain and
+// valid once applied on top of global params
+func ValidateChainCheckpointParams(p Params, chainParams []ChainCheckpointParams) error {
+	seen := make(map[string]bool, len(chainParams))
+	for _, cp := range chainParams {
+		if cp.RootChainType == "" {
+			return fmt.Errorf("root chain type of chain checkpoint params should not be empty")
+		}
+
+		if seen[cp.RootChainType] {
+			return fmt.Errorf("duplicate chain checkpoint params for %s", cp.RootChainType)
+		}
+		seen[cp.RootChainType] = true
+
+		effective := p
+		effective.ChainParams = []ChainCheckpointParams{cp}
+		effective = effective.ForRootChain(cp.RootChainType)
+		if effective.MaxCheckpointLength < effective.AvgCheckpointLength {
+			return fmt.Errorf("AvgCheckpointLength should not be greater than MaxCheckpointLength for %s", cp.RootChainType)
+		}
+	}
+
+	return nil
+}
+
+// ValidateSyncTargetChains checks sync target chains are non-empty and unique
+func ValidateSyncTargetChains(targets []string) error {
+	seen := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		if target == "" {
+			return fmt.Errorf("sync target chain should not be empty")
+		}
+
+		if seen[target] {
+			return fmt.Errorf("duplicate sync target chain %s", target)
+		}
+		seen[target] = true
+	}
+
 	return nil
 }
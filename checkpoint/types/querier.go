@@ -1,5 +1,9 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
 	QueryParams               = "params"
@@ -7,11 +11,15 @@ const (
 	QueryEpoch                = "epoch"
 	QueryCheckpoint           = "checkpoint"
 	QueryCheckpointBuffer     = "checkpoint-buffer"
+	QueryPendingCheckpoints   = "pending-checkpoints"
+	QueryCheckpointRelay      = "checkpoint-relay"
 	QueryCheckpointSyncBuffer = "checkpoint-sync"
 	QueryCheckpointActivation = "checkpoint-activation"
 	QueryLastNoAck            = "last-no-ack"
 	QueryCheckpointList       = "checkpoint-list"
 	QueryNextCheckpoint       = "next-checkpoint"
+	QueryCheckpointProof      = "checkpoint-proof"
+	QueryCheckpointByBlock    = "checkpoint-by-block"
 	QueryProposer             = "is-proposer"
 	QueryCurrentProposer      = "current-proposer"
 	StakingQuerierRoute       = "staking"
@@ -21,6 +29,9 @@ const (
 type QueryCheckpointParams struct {
 	Number    uint64
 	RootChain string
+
+	// staking chain of checkpoint sync buffer, stake chain if empty
+	TargetChain string `json:",omitempty"`
 }
 
 // NewQueryCheckpointParams creates a new instance of QueryCheckpointHeaderIndex.
@@ -31,6 +42,14 @@ func NewQueryCheckpointParams(number uint64, rootChain string) QueryCheckpointPa
 	}
 }
 
+// NewQueryCheckpointSyncParams creates a new instance of QueryCheckpointParams for checkpoint sync buffer.
+func NewQueryCheckpointSyncParams(rootChain string, targetChain string) QueryCheckpointParams {
+	return QueryCheckpointParams{
+		RootChain:   rootChain,
+		TargetChain: targetChain,
+	}
+}
+
 // QueryBorChainID defines the params for querying with bor chain id
 type QueryBorChainID struct {
 	BorChainID string
@@ -40,3 +59,70 @@ type QueryBorChainID struct {
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// QueryBlockProofParams defines the params for querying block inclusion proof
+type QueryBlockProofParams struct {
+	BlockNumber uint64
+	RootChain   string
+}
+
+// NewQueryBlockProofParams creates a new instance of QueryBlockProofParams.
+func NewQueryBlockProofParams(blockNumber uint64, rootChain string) QueryBlockProofParams {
+	return QueryBlockProofParams{
+		BlockNumber: blockNumber,
+		RootChain:   rootChain,
+	}
+}
+
+// BlockProof represents merkle inclusion proof of a bor block against a checkpoint root hash
+type BlockProof struct {
+	RootChain        string               `json:"root_chain"`
+	CheckpointNumber uint64               `json:"checkpoint_number"`
+	Checkpoint       hmTypes.Checkpoint   `json:"checkpoint"`
+	BlockNumber      uint64               `json:"block_number"`
+	LeafIndex        uint64               `json:"leaf_index"`
+	Leaf             hmTypes.HeimdallHash `json:"leaf"`
+	Proof            hmTypes.HexBytes     `json:"proof"`
+}
+
+// Ack status of a child block on a root chain
+const (
+	BlockStatusAcked    = "acked"    // covered by an acked checkpoint
+	BlockStatusBuffered = "buffered" // covered by the checkpoint in buffer waiting for ack
+	BlockStatusPending  = "pending"  // not checkpointed yet
+)
+
+// BlockCheckpointStatus represents checkpoint covering a child block on a root chain
+type BlockCheckpointStatus struct {
+	RootChain        string              `json:"root_chain"`
+	Status           string              `json:"status"`
+	CheckpointNumber uint64              `json:"checkpoint_number,omitempty"`
+	Checkpoint       *hmTypes.Checkpoint `json:"checkpoint,omitempty"`
+}
+
+// BlockCheckpoints represents checkpoints covering a child block on each root chain
+type BlockCheckpoints struct {
+	BlockNumber uint64                  `json:"block_number"`
+	RootChains  []BlockCheckpointStatus `json:"root_chains"`
+}
+
+// RelayCheckpointMethod root chain contract method accepting signed checkpoints
+const RelayCheckpointMethod = "submitCheckpoint"
+
+// CheckpointRelayInfo checkpoint waiting for ack with its number
+type CheckpointRelayInfo struct {
+	Number     uint64             `json:"number"`
+	Checkpoint hmTypes.Checkpoint `json:"checkpoint"`
+}
+
+// CheckpointRelayPayload approved checkpoint ready to be submitted to root chain by anyone
+type CheckpointRelayPayload struct {
+	RootChain  string               `json:"root_chain"`
+	Number     uint64               `json:"number"`
+	Checkpoint hmTypes.Checkpoint   `json:"checkpoint"`
+	TxHash     hmTypes.HeimdallHash `json:"tx_hash"`
+	Contract   string               `json:"contract"`
+	Method     string               `json:"method"`
+	Data       string               `json:"data"`
+	Sigs       [][3]string          `json:"sigs"`
+}
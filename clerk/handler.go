@@ -44,6 +44,12 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 		return types.ErrEventRecordAlreadySynced(k.Codespace()).Result()
 	}
 
+	// paused or retired chain doesn't ingest state records
+	if !k.chainKeeper.IsChainActive(ctx, msg.RootChainType) {
+		k.Logger(ctx).Error("Root chain is not active", "root", msg.RootChainType)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
+
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
 	chainParams := params.ChainParams
@@ -56,7 +62,7 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 
 	// sequence id
 	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := helper.CalculateSequence(blockNumber, msg.LogIndex, msg.RootChainType)
+	sequence := helper.CalculateSequence(blockNumber, msg.LogIndex, k.chainKeeper.GetRootChainID(ctx, msg.RootChainType))
 
 	// check if incoming tx is older
 	if k.HasRecordSequence(ctx, sequence.String()) {
@@ -152,7 +152,7 @@ func (suite *HandlerTestSuite) TestHandleMsgEventRecordSequence() {
 
 	// sequence id
 	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := helper.CalculateSequence(blockNumber, msg.LogIndex, msg.RootChainType)
+	sequence := helper.CalculateSequence(blockNumber, msg.LogIndex, hmTypes.GetRootChainID(msg.RootChainType))
 	app.ClerkKeeper.SetRecordSequence(ctx, sequence.String())
 
 	result := suite.handler(ctx, msg)
@@ -173,7 +173,7 @@ func (suite *HandlerTestSuite) TestHandleMsgEventRecordSequence() {
 
 	// sequence id
 	blockNumber = new(big.Int).SetUint64(msg.BlockNumber)
-	sequence = helper.CalculateSequence(blockNumber, msg.LogIndex, msg.RootChainType)
+	sequence = helper.CalculateSequence(blockNumber, msg.LogIndex, hmTypes.GetRootChainID(msg.RootChainType))
 	app.ClerkKeeper.SetRecordSequence(ctx, sequence.String())
 
 	result = suite.handler(ctx, msg)
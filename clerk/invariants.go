@@ -0,0 +1,55 @@
+package clerk
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+)
+
+// RegisterInvariants registers all clerk invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "event-records", EventRecordsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the clerk module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return EventRecordsInvariant(keeper)(ctx)
+	}
+}
+
+// EventRecordsInvariant checks that records 1..latest id are stored and nothing beyond them
+func EventRecordsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		latestID := keeper.GetLatestID(ctx)
+
+		var storedCount uint64
+
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), StateRecordPrefixKey)
+		for ; iterator.Valid(); iterator.Next() {
+			storedCount++
+		}
+		iterator.Close()
+
+		if storedCount != latestID {
+			broken = true
+			msg += fmt.Sprintf("\tlatest id %d but %d records stored\n", latestID, storedCount)
+		}
+
+		for id := uint64(1); id <= latestID; id++ {
+			if !keeper.HasEventRecord(ctx, id) {
+				broken = true
+				msg += fmt.Sprintf("\trecord %d missing\n", id)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "event-records", msg), broken
+	}
+}
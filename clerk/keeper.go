@@ -32,6 +32,8 @@ var (
 
 	BscStateRecordPrefixKeyWithTime = []byte{0x16} // bsc prefix key for when storing state with time
 
+	RootChainStateRecordPrefixKeyWithTime = []byte{0x1a} // prefix key for when storing state with time of chains added by chain manager
+
 	LatestMsgIDPrefixKey = []byte{0x18} // eth prefix key for when storing state
 
 	RootIdToHeimdallIDPrefixKey = []byte{0x19} // store <rootChainID, hemidall chainID>
@@ -82,7 +84,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // SetEventRecordWithTime sets event record id with time
 func (k *Keeper) SetEventRecordWithTime(ctx sdk.Context, record types.EventRecord, latestID uint64) error {
 	// set root chain msg ID
-	rootChainKey := GetRootChainEventRecordKeyWithTimePrefix(record.RootChainType, record.ID, record.RecordTime)
+	rootChainID := k.chainKeeper.GetRootChainID(ctx, record.RootChainType)
+	rootChainKey := GetRootChainEventRecordKeyWithTimePrefix(record.RootChainType, rootChainID, record.ID, record.RecordTime)
 	value, err := k.cdc.MarshalBinaryBare(record.ID)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling record", "error", err)
@@ -373,7 +376,7 @@ func GetEventRecordKeyWithTimePrefix(recordTime time.Time) []byte {
 }
 
 // GetRootChainEventRecordKeyWithTimePrefix gives prefix for record time key
-func GetRootChainEventRecordKeyWithTimePrefix(rootChainType string, id uint64, recordTime time.Time) []byte {
+func GetRootChainEventRecordKeyWithTimePrefix(rootChainType string, rootChainID byte, id uint64, recordTime time.Time) []byte {
 
 	key := DefaultValue
 	switch rootChainType {
@@ -384,7 +387,10 @@ func GetRootChainEventRecordKeyWithTimePrefix(rootChainType string, id uint64, r
 	case hmTypes.RootChainTypeBsc:
 		key = BscStateRecordPrefixKeyWithTime
 	default:
-		return nil
+		if rootChainID == 0 {
+			return nil
+		}
+		key = append(append([]byte{}, RootChainStateRecordPrefixKeyWithTime...), rootChainID)
 	}
 	return GetRecordKey(GetRecordKeyWithTimePrefix(key, recordTime), id)
 }
@@ -199,3 +199,22 @@ func (suite *KeeperTestSuite) TestSetHasGetRecordSequence() {
 	recordSequences := ck.GetRecordSequences(ctx)
 	require.Len(t, recordSequences, 1)
 }
+
+func (suite *KeeperTestSuite) TestEventRecordsInvariant() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	ck := app.ClerkKeeper
+
+	for i := uint64(1); i <= 3; i++ {
+		testRecord := types.NewEventRecord(hmTypes.HexToHeimdallHash("123"), 1, i, hmTypes.BytesToHeimdallAddress([]byte("addr")), hmTypes.HexBytes{}, "1", time.Now(), hmTypes.RootChainTypeStake)
+		require.NoError(t, ck.SetEventRecord(ctx, testRecord))
+	}
+
+	_, broken := clerk.EventRecordsInvariant(ck)(ctx)
+	require.False(t, broken)
+
+	ck.SetLatestID(ctx, 5)
+
+	msg, broken := clerk.EventRecordsInvariant(ck)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "latest id 5 but 3 records stored")
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/maticnetwork/heimdall/clerk/types"
@@ -100,35 +99,30 @@ func handleQueryRecordSequence(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	chainParams := keeper.chainKeeper.GetParams(ctx)
-	var receipt *ethTypes.Receipt
-	var err error
+	adapter, err := helper.GetRootChainAdapter(contractCallerObj, params.RootChainType)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("wrong chain type = " + params.RootChainType + "please pass correct chainType like eth or tron"))
+	}
 
-	// get main tx receipt
-	switch params.RootChainType {
-	case hmTypes.RootChainTypeEth:
-		targetFeature := util.GetFeatureConfig().GetFeature(ctx, featuremanagerTypes.FinalizedEth)
-		finalizedEth := targetFeature.IsOpen
-		receipt, err = contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(),
-			chainParams.MainchainTxConfirmations, hmTypes.RootChainTypeEth, finalizedEth)
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := keeper.chainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
-		if err != nil {
-			return nil, sdk.ErrInternal(fmt.Sprintf("wrong chain type = " + params.RootChainType + "plealse pass correct chainType like bsc"))
-		}
-		receipt, err = contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(),
-			bscChain.TxConfirmations, hmTypes.RootChainTypeBsc, false)
-	case hmTypes.RootChainTypeTron:
-		receipt, err = contractCallerObj.GetTronTransactionReceipt(hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
-	default:
+	txConfirmations, err := keeper.chainKeeper.GetTxConfirmations(ctx, params.RootChainType)
+	if err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("wrong chain type = " + params.RootChainType + "please pass correct chainType like eth or tron"))
 	}
+
+	// finalized blocks are only used for eth
+	finalized := false
+	if params.RootChainType == hmTypes.RootChainTypeEth {
+		finalized = util.GetFeatureConfig().GetFeature(ctx, featuremanagerTypes.FinalizedEth).IsOpen
+	}
+
+	// get main tx receipt
+	receipt, err := adapter.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash), txConfirmations, finalized)
 	if err != nil || receipt == nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("Transaction is not confirmed yet. Please wait for sometime and try again"))
 	}
 
 	// sequence id
-	sequence := helper.CalculateSequence(receipt.BlockNumber, params.LogIndex, params.RootChainType)
+	sequence := helper.CalculateSequence(receipt.BlockNumber, params.LogIndex, keeper.chainKeeper.GetRootChainID(ctx, params.RootChainType))
 	// check if incoming tx already exists
 	if !keeper.HasRecordSequence(ctx, sequence.String()) {
 		return nil, nil
@@ -229,7 +229,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	require.Nil(t, err)
 	require.Nil(t, resp)
 
-	testSeq := helper.CalculateSequence(big.NewInt(1), 1, rootChainType).String()
+	testSeq := helper.CalculateSequence(big.NewInt(1), 1, hmTypes.GetRootChainID(rootChainType)).String()
 	ck := app.ClerkKeeper
 	ck.SetRecordSequence(ctx, testSeq)
 	logIndex = uint64(1)
@@ -244,7 +244,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	require.NotNil(t, resp)
 
 	// tron
-	testSeq = helper.CalculateSequence(big.NewInt(1), 1, hmTypes.RootChainTypeTron).String()
+	testSeq = helper.CalculateSequence(big.NewInt(1), 1, hmTypes.GetRootChainID(hmTypes.RootChainTypeTron)).String()
 	ck.SetRecordSequence(ctx, testSeq)
 	suite.contractCaller.On("GetTronTransactionReceipt", txHash.TronHash().String()).Return(txreceipt, nil)
 	req = abci.RequestQuery{
@@ -8,10 +8,6 @@ import (
 	featuremanagerTypes "github.com/maticnetwork/heimdall/featuremanager/types"
 	"github.com/maticnetwork/heimdall/featuremanager/util"
 
-	ethCommon "github.com/ethereum/go-ethereum/common"
-
-	ethTypes "github.com/ethereum/go-ethereum/core/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/heimdall/clerk/types"
@@ -60,50 +56,37 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 		"rootChainType", msg.RootChainType,
 	)
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
+	adapter, err := helper.GetRootChainAdapter(contractCaller, msg.RootChainType)
+	if err != nil {
+		k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, " has no root chain adapter")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
 
-	// get confirmed tx receipt
-	var receipt *ethTypes.Receipt
-	var err error
-	// get main tx receipt
-	var contractAddress ethCommon.Address
-
-	switch msg.RootChainType {
-	case hmTypes.RootChainTypeEth:
-		targetFeature := util.GetFeatureConfig().GetFeature(ctx, featuremanagerTypes.FinalizedEth)
-		finalizedEth := targetFeature.IsOpen
-		receipt, err = contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations,
-			hmTypes.RootChainTypeEth, finalizedEth)
-		if err != nil || receipt == nil {
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
-		}
-		contractAddress = chainParams.StateSenderAddress.EthAddress()
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.chainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
-		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, " does not  match bsc")
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
-		}
-		receipt, err = contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), bscChain.TxConfirmations,
-			hmTypes.RootChainTypeBsc, false)
-		if err != nil || receipt == nil {
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
-		}
-		contractAddress = bscChain.StateSenderAddress.EthAddress()
-	case hmTypes.RootChainTypeTron:
-		receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
-		if err != nil || receipt == nil {
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
-		}
-		contractAddress = hmTypes.HexToTronAddress(chainParams.TronStateSenderAddress)
-	default:
-		k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, " does not  match eth or tron")
+	contracts, err := k.chainKeeper.GetRootChainContracts(ctx, msg.RootChainType)
+	if err != nil {
+		k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, " has no chain params")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
 
-	eventLog, err := contractCaller.DecodeStateSyncedEvent(contractAddress, receipt, msg.LogIndex)
+	txConfirmations, err := k.chainKeeper.GetTxConfirmations(ctx, msg.RootChainType)
+	if err != nil {
+		k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, " has no chain params")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
+	}
+
+	// finalized blocks are only used for eth
+	finalized := false
+	if msg.RootChainType == hmTypes.RootChainTypeEth {
+		finalized = util.GetFeatureConfig().GetFeature(ctx, featuremanagerTypes.FinalizedEth).IsOpen
+	}
+
+	// get confirmed tx receipt
+	receipt, err := adapter.GetConfirmedTxReceipt(msg.TxHash, txConfirmations, finalized)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	eventLog, err := adapter.DecodeStateSyncedEvent(receipt, msg.LogIndex, contracts)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -164,7 +147,7 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 
 	// sequence id
 	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-	sequence := helper.CalculateSequence(blockNumber, msg.LogIndex, msg.RootChainType)
+	sequence := helper.CalculateSequence(blockNumber, msg.LogIndex, k.chainKeeper.GetRootChainID(ctx, msg.RootChainType))
 	// create event record
 	record := types.NewEventRecord(
 		msg.TxHash,
@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -166,6 +167,86 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgEventRecord() {
 		require.Error(t, err)
 
 	})
+	t.Run("SuccessWithRootChainAdapter", func(t *testing.T) {
+		adapter := &mocks.RootChainAdapter{}
+		helper.RegisterRootChainAdapter(hmTypes.RootChainTypeEth, func(helper.IContractCaller, string) helper.RootChainAdapter {
+			return adapter
+		})
+		defer helper.RegisterRootChainAdapter(hmTypes.RootChainTypeEth, helper.NewEVMRootChainAdapter)
+
+		logIndex := uint64(12)
+		blockNumber := uint64(601)
+		txReceipt := &ethTypes.Receipt{
+			BlockNumber: new(big.Int).SetUint64(blockNumber),
+		}
+		txHash := hmTypes.HexToHeimdallHash("adapter hash")
+
+		msg := types.NewMsgEventRecord(
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			txHash,
+			logIndex,
+			blockNumber,
+			id,
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			make([]byte, 0),
+			suite.chainID,
+			hmTypes.RootChainTypeEth,
+		)
+
+		contracts, err := app.ChainKeeper.GetRootChainContracts(ctx, hmTypes.RootChainTypeEth)
+		require.NoError(t, err)
+
+		// mock adapter calls, contract caller must not be used
+		adapter.On("GetConfirmedTxReceipt", txHash, chainParams.MainchainTxConfirmations, false).Return(txReceipt, nil)
+		event := &statesender.StatesenderStateSynced{
+			Id:              new(big.Int).SetUint64(msg.ID),
+			ContractAddress: msg.ContractAddress.EthAddress(),
+			Data:            msg.Data,
+		}
+		adapter.On("DecodeStateSyncedEvent", txReceipt, logIndex, contracts).Return(event, nil)
+
+		// execute handler
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+		adapter.AssertExpectations(t)
+	})
+
+	t.Run("NoLogFromRootChainAdapter", func(t *testing.T) {
+		adapter := &mocks.RootChainAdapter{}
+		helper.RegisterRootChainAdapter(hmTypes.RootChainTypeEth, func(helper.IContractCaller, string) helper.RootChainAdapter {
+			return adapter
+		})
+		defer helper.RegisterRootChainAdapter(hmTypes.RootChainTypeEth, helper.NewEVMRootChainAdapter)
+
+		logIndex := uint64(13)
+		blockNumber := uint64(602)
+		txReceipt := &ethTypes.Receipt{
+			BlockNumber: new(big.Int).SetUint64(blockNumber),
+		}
+		txHash := hmTypes.HexToHeimdallHash("adapter no log hash")
+
+		msg := types.NewMsgEventRecord(
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			txHash,
+			logIndex,
+			blockNumber,
+			id,
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			make([]byte, 0),
+			suite.chainID,
+			hmTypes.RootChainTypeEth,
+		)
+
+		adapter.On("GetConfirmedTxReceipt", txHash, chainParams.MainchainTxConfirmations, false).Return(txReceipt, nil)
+		adapter.On("DecodeStateSyncedEvent", txReceipt, logIndex, mock.Anything).Return(nil, nil)
+
+		// execute handler
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeErrDecodeEvent), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+
 	t.Run("NoReceipt", func(t *testing.T) {
 		suite.contractCaller = mocks.IContractCaller{}
 
@@ -281,7 +362,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecord() {
 
 		// sequence id
 		blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
-		sequence := helper.CalculateSequence(blockNumber, logIndex, msg.RootChainType)
+		sequence := helper.CalculateSequence(blockNumber, logIndex, hmTypes.GetRootChainID(msg.RootChainType))
 
 		// check sequence
 		hasSequence := app.ClerkKeeper.HasRecordSequence(ctx, sequence.String())
@@ -72,6 +72,9 @@ const (
 	FlagMinGasPrices = "minimum-gas-prices"
 	FlagHaltHeight   = "halt-height"
 	FlagHaltTime     = "halt-time"
+
+	FlagInvCheckPeriod = "inv-check-period"
+	FlagInvCheckHalt   = "inv-check-halt"
 )
 
 // nolint
@@ -181,8 +184,11 @@ func getNewApp(serverCtx *server.Context) func(logger log.Logger, db dbm.DB, sto
 		helper.InitDeliveryConfig("")
 		helper.UpdateTendermintConfig(serverCtx.Config, viper.GetViper())
 		// create new heimdall app
-		return app.NewHeimdallApp(logger, db,
+		hApp := app.NewHeimdallApp(logger, db,
 			baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))))
+		hApp.SetInvariantCheck(viper.GetUint64(FlagInvCheckPeriod), viper.GetBool(FlagInvCheckHalt))
+
+		return hApp
 	}
 }
 
@@ -263,6 +269,10 @@ which accepts a path for the resulting pprof file.
 		"Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().String(flagCPUProfile, "",
 		"Enable CPU profiling and write to the provided file")
+	cmd.Flags().Uint64(FlagInvCheckPeriod, 0,
+		"Assert registered invariants every N blocks, 0 disables the check")
+	cmd.Flags().Bool(FlagInvCheckHalt, false,
+		"Halt the node when an invariant is broken instead of logging it")
 
 	cmd.Flags().String(helper.FlagClientHome, helper.DefaultCLIHome, "client's home directory")
 
@@ -32,6 +32,7 @@ const (
 	CodeWrongRootChain           CodeType = 1512
 	CodeNoChainParams            CodeType = 1513
 	CodeChainParamsExist         CodeType = 1514
+	CodeChainInactive            CodeType = 1515
 
 	CodeOldValidator        CodeType = 2500
 	CodeNoValidator         CodeType = 2501
@@ -49,6 +50,8 @@ const (
 	CodeNoSignerChangeError CodeType = 2513
 	CodeNonce               CodeType = 2514
 	CodeNoStakingEvent      CodeType = 2515
+	CodeNoDelegation        CodeType = 2516
+	CodeInsufficientShares  CodeType = 2517
 
 	CodeSpanNotCountinuous  CodeType = 3501
 	CodeUnableToFreezeSet   CodeType = 3502
@@ -75,6 +78,7 @@ const (
 	CodeTickNotInContinuity    CodeType = 6504
 	CodeTickAckNotInContinuity CodeType = 6505
 	CodeWrongRootChainType     CodeType = 6506
+	CodeInvalidSideTxEvidence  CodeType = 6507
 )
 
 // -------- Invalid msg
@@ -145,6 +149,10 @@ func ErrChainPamramsExist(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeChainParamsExist, "root chain chain params has exist")
 }
 
+func ErrChainInactive(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeChainInactive, "root chain is paused or retired")
+}
+
 func ErrInvalidNoACK(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidNoACK, "Invalid No ACK -- Waiting for last checkpoint ACK")
 }
@@ -219,6 +227,14 @@ func ErrNoStakingFound(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeNoStakingEvent, "Staking not found")
 }
 
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeNoDelegation, "Delegation not found")
+}
+
+func ErrInsufficientShares(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInsufficientShares, "Insufficient delegation shares")
+}
+
 // Bor Errors --------------------------------
 
 func ErrInvalidBorChainID(codespace sdk.CodespaceType) sdk.Error {
@@ -301,6 +317,8 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "Checkpoint not in countinuity"
 	case CodeNoCheckpointBuffer:
 		return "Checkpoint buffer Not Found"
+	case CodeChainInactive:
+		return "Root chain is paused or retired"
 
 	case CodeOldValidator:
 		return "Start Epoch behind Current Epoch"
@@ -375,3 +393,7 @@ func ErrTickNotInContinuity(codespace sdk.CodespaceType) sdk.Error {
 func ErrTickAckNotInContinuity(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeTickAckNotInContinuity, "Tick-ack not in countinuity")
 }
+
+func ErrInvalidSideTxEvidence(codespace sdk.CodespaceType, format string, args ...interface{}) sdk.Error {
+	return newError(codespace, CodeInvalidSideTxEvidence, fmt.Sprintf(format, args...))
+}
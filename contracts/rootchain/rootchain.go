@@ -30,7 +30,7 @@ var (
 
 // RootchainMetaData contains all meta data concerning the Rootchain contract.
 var RootchainMetaData = &bind.MetaData{
-	ABI: "[{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"headerBlocks\",\"outputs\":[{\"name\":\"root\",\"type\":\"bytes32\"},{\"name\":\"start\",\"type\":\"uint256\"},{\"name\":\"end\",\"type\":\"uint256\"},{\"name\":\"createdAt\",\"type\":\"uint256\"},{\"name\":\"proposer\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\"},{\"name\":\"sigs\",\"type\":\"uint256[3][]\"}],\"name\":\"submitCheckpoint\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\"},{\"name\":\"sigs\",\"type\":\"bytes\"}],\"name\":\"submitHeaderBlock\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getLastChildBlock\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"currentHeaderBlock\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"proposer\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"headerBlockId\",\"type\":\"uint256\"},{\"indexed\":true,\"name\":\"reward\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"start\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"end\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"NewHeaderBlock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"rootChainId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"txConfirmations\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"rootChainAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"stateSenderAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"stakingManagerAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"stakingInfoAddress\",\"type\":\"address\"}],\"name\":\"NewChain\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"chainMap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"rootChainId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"txConfirmations\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rootChainAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stateSenderAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingManagerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingInfoAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"timeStamp\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"rootChainId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"txConfirmations\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rootChainAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stateSenderAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingManagerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingInfoAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"timeStamp\",\"type\":\"uint256\"}],\"name\":\"setChainInfo\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"headerBlocks\",\"outputs\":[{\"name\":\"root\",\"type\":\"bytes32\"},{\"name\":\"start\",\"type\":\"uint256\"},{\"name\":\"end\",\"type\":\"uint256\"},{\"name\":\"createdAt\",\"type\":\"uint256\"},{\"name\":\"proposer\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\"},{\"name\":\"sigs\",\"type\":\"uint256[3][]\"}],\"name\":\"submitCheckpoint\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\"},{\"name\":\"sigs\",\"type\":\"bytes\"}],\"name\":\"submitHeaderBlock\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getLastChildBlock\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"currentHeaderBlock\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"proposer\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"headerBlockId\",\"type\":\"uint256\"},{\"indexed\":true,\"name\":\"reward\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"start\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"end\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"NewHeaderBlock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"rootChainId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"txConfirmations\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"rootChainAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"stateSenderAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"stakingManagerAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"stakingInfoAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rootChainType\",\"type\":\"string\"}],\"name\":\"NewChain\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"rootChainId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveHeight\",\"type\":\"uint256\"}],\"name\":\"ChainStatusChanged\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"chainMap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"rootChainId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"txConfirmations\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rootChainAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stateSenderAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingManagerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingInfoAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"timeStamp\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"rootChainId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"activationHeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"txConfirmations\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rootChainAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stateSenderAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingManagerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"stakingInfoAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"timeStamp\",\"type\":\"uint256\"}],\"name\":\"setChainInfo\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // RootchainABI is the input ABI used to generate the binding from.
@@ -439,6 +439,152 @@ func (_Rootchain *RootchainTransactorSession) SubmitHeaderBlock(data []byte, sig
 	return _Rootchain.Contract.SubmitHeaderBlock(&_Rootchain.TransactOpts, data, sigs)
 }
 
+// RootchainChainStatusChangedIterator is returned from FilterChainStatusChanged and is used to iterate over the raw logs and unpacked data for ChainStatusChanged events raised by the Rootchain contract.
+type RootchainChainStatusChangedIterator struct {
+	Event *RootchainChainStatusChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RootchainChainStatusChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RootchainChainStatusChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RootchainChainStatusChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RootchainChainStatusChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RootchainChainStatusChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RootchainChainStatusChanged represents a ChainStatusChanged event raised by the Rootchain contract.
+type RootchainChainStatusChanged struct {
+	RootChainId     *big.Int
+	Status          uint8
+	EffectiveHeight *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterChainStatusChanged is a free log retrieval operation binding the contract event 0xa8be35d5baafffe1aeb09e0c115f6b249ae8297b2c6462a6ea57d6ffd7b96f3a.
+//
+// Solidity: event ChainStatusChanged(uint256 indexed rootChainId, uint8 status, uint256 effectiveHeight)
+func (_Rootchain *RootchainFilterer) FilterChainStatusChanged(opts *bind.FilterOpts, rootChainId []*big.Int) (*RootchainChainStatusChangedIterator, error) {
+
+	var rootChainIdRule []interface{}
+	for _, rootChainIdItem := range rootChainId {
+		rootChainIdRule = append(rootChainIdRule, rootChainIdItem)
+	}
+
+	logs, sub, err := _Rootchain.contract.FilterLogs(opts, "ChainStatusChanged", rootChainIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RootchainChainStatusChangedIterator{contract: _Rootchain.contract, event: "ChainStatusChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchChainStatusChanged is a free log subscription operation binding the contract event 0xa8be35d5baafffe1aeb09e0c115f6b249ae8297b2c6462a6ea57d6ffd7b96f3a.
+//
+// Solidity: event ChainStatusChanged(uint256 indexed rootChainId, uint8 status, uint256 effectiveHeight)
+func (_Rootchain *RootchainFilterer) WatchChainStatusChanged(opts *bind.WatchOpts, sink chan<- *RootchainChainStatusChanged, rootChainId []*big.Int) (event.Subscription, error) {
+
+	var rootChainIdRule []interface{}
+	for _, rootChainIdItem := range rootChainId {
+		rootChainIdRule = append(rootChainIdRule, rootChainIdItem)
+	}
+
+	logs, sub, err := _Rootchain.contract.WatchLogs(opts, "ChainStatusChanged", rootChainIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RootchainChainStatusChanged)
+				if err := _Rootchain.contract.UnpackLog(event, "ChainStatusChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChainStatusChanged is a log parse operation binding the contract event 0xa8be35d5baafffe1aeb09e0c115f6b249ae8297b2c6462a6ea57d6ffd7b96f3a.
+//
+// Solidity: event ChainStatusChanged(uint256 indexed rootChainId, uint8 status, uint256 effectiveHeight)
+func (_Rootchain *RootchainFilterer) ParseChainStatusChanged(log types.Log) (*RootchainChainStatusChanged, error) {
+	event := new(RootchainChainStatusChanged)
+	if err := _Rootchain.contract.UnpackLog(event, "ChainStatusChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // RootchainNewChainIterator is returned from FilterNewChain and is used to iterate over the raw logs and unpacked data for NewChain events raised by the Rootchain contract.
 type RootchainNewChainIterator struct {
 	Event *RootchainNewChain // Event containing the contract specifics and raw log
@@ -515,12 +661,13 @@ type RootchainNewChain struct {
 	StateSenderAddress    common.Address
 	StakingManagerAddress common.Address
 	StakingInfoAddress    common.Address
+	RootChainType         string
 	Raw                   types.Log // Blockchain specific contextual infos
 }
 
-// FilterNewChain is a free log retrieval operation binding the contract event 0x46d8e11a9f6eb349dbb840ef59be3b9f104a0ec5ef156698d105d99a2a33c00c.
+// FilterNewChain is a free log retrieval operation binding the contract event 0xd3668a7b8327cb1108890f3caf045c97588370e8aa60f33a230800940dad63c0.
 //
-// Solidity: event NewChain(uint256 indexed rootChainId, uint256 activationHeight, uint256 txConfirmations, address rootChainAddress, address stateSenderAddress, address stakingManagerAddress, address stakingInfoAddress)
+// Solidity: event NewChain(uint256 indexed rootChainId, uint256 activationHeight, uint256 txConfirmations, address rootChainAddress, address stateSenderAddress, address stakingManagerAddress, address stakingInfoAddress, string rootChainType)
 func (_Rootchain *RootchainFilterer) FilterNewChain(opts *bind.FilterOpts, rootChainId []*big.Int) (*RootchainNewChainIterator, error) {
 
 	var rootChainIdRule []interface{}
@@ -535,9 +682,9 @@ func (_Rootchain *RootchainFilterer) FilterNewChain(opts *bind.FilterOpts, rootC
 	return &RootchainNewChainIterator{contract: _Rootchain.contract, event: "NewChain", logs: logs, sub: sub}, nil
 }
 
-// WatchNewChain is a free log subscription operation binding the contract event 0x46d8e11a9f6eb349dbb840ef59be3b9f104a0ec5ef156698d105d99a2a33c00c.
+// WatchNewChain is a free log subscription operation binding the contract event 0xd3668a7b8327cb1108890f3caf045c97588370e8aa60f33a230800940dad63c0.
 //
-// Solidity: event NewChain(uint256 indexed rootChainId, uint256 activationHeight, uint256 txConfirmations, address rootChainAddress, address stateSenderAddress, address stakingManagerAddress, address stakingInfoAddress)
+// Solidity: event NewChain(uint256 indexed rootChainId, uint256 activationHeight, uint256 txConfirmations, address rootChainAddress, address stateSenderAddress, address stakingManagerAddress, address stakingInfoAddress, string rootChainType)
 func (_Rootchain *RootchainFilterer) WatchNewChain(opts *bind.WatchOpts, sink chan<- *RootchainNewChain, rootChainId []*big.Int) (event.Subscription, error) {
 
 	var rootChainIdRule []interface{}
@@ -577,9 +724,9 @@ func (_Rootchain *RootchainFilterer) WatchNewChain(opts *bind.WatchOpts, sink ch
 	}), nil
 }
 
-// ParseNewChain is a log parse operation binding the contract event 0x46d8e11a9f6eb349dbb840ef59be3b9f104a0ec5ef156698d105d99a2a33c00c.
+// ParseNewChain is a log parse operation binding the contract event 0xd3668a7b8327cb1108890f3caf045c97588370e8aa60f33a230800940dad63c0.
 //
-// Solidity: event NewChain(uint256 indexed rootChainId, uint256 activationHeight, uint256 txConfirmations, address rootChainAddress, address stateSenderAddress, address stakingManagerAddress, address stakingInfoAddress)
+// Solidity: event NewChain(uint256 indexed rootChainId, uint256 activationHeight, uint256 txConfirmations, address rootChainAddress, address stateSenderAddress, address stakingManagerAddress, address stakingInfoAddress, string rootChainType)
 func (_Rootchain *RootchainFilterer) ParseNewChain(log types.Log) (*RootchainNewChain, error) {
 	event := new(RootchainNewChain)
 	if err := _Rootchain.contract.UnpackLog(event, "NewChain", log); err != nil {
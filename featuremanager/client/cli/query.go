@@ -26,6 +26,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetTargetFeature(cdc),
 			GetAllFeatures(cdc),
 			GetAllSupportedFeatures(cdc),
+			GetPendingFeatures(cdc),
+			GetFeatureSchemas(cdc),
+			GetFeatureSignals(cdc),
+			GetSignalPower(cdc),
+			GetFeatureHistory(cdc),
 		)...,
 	)
 
@@ -147,3 +152,209 @@ $ %s query featuremanager supported-features
 		},
 	}
 }
+
+// GetPendingFeatures implements the pending-features query command.
+func GetPendingFeatures(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "pending-features",
+		Args:  cobra.NoArgs,
+		Short: "show features with activation or deactivation scheduled in future",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query features waiting for activation or deactivation height.
+Example:
+$ %s query featuremanager pending-features
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingFeatures)
+
+			data, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var pendingFeatures types.PendingFeatures
+			if err = json.Unmarshal(data, &pendingFeatures); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(pendingFeatures)
+		},
+	}
+}
+
+// GetFeatureSchemas implements the feature-schemas query command.
+func GetFeatureSchemas(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "feature-schemas [feature]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "show config keys accepted by registered features",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query int_conf and string_conf keys, types and ranges accepted by features.
+Example:
+$ %[1]s query featuremanager feature-schemas
+$ %[1]s query featuremanager feature-schemas DynamicCheckpoint
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureSchemas)
+
+			data, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var schemas types.FeatureSchemas
+			if err = json.Unmarshal(data, &schemas); err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				schema, ok := schemas[args[0]]
+				if !ok {
+					return fmt.Errorf("feature %s is not registered", args[0])
+				}
+
+				schemas = types.FeatureSchemas{args[0]: schema}
+			}
+
+			return cliCtx.PrintOutput(schemas)
+		},
+	}
+}
+
+// GetFeatureSignals implements the feature-signals query command.
+func GetFeatureSignals(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "feature-signals",
+		Args:  cobra.NoArgs,
+		Short: "show features signalled by validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query features each validator signalled its binary supports.
+Example:
+$ %s query featuremanager feature-signals
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureSignals)
+
+			data, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var signals types.FeatureSignals
+			if err = json.Unmarshal(data, &signals); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(signals)
+		},
+	}
+}
+
+// GetSignalPower implements the signal-power query command.
+func GetSignalPower(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "signal-power [feature]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show voting power of current validators which signalled feature",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query voting power of current validators which signalled feature.
+Example:
+$ %s query featuremanager signal-power FinalizedEth
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySignalPower)
+
+			qp, err := cliCtx.Codec.MarshalJSON(types.NewQueryTargeFeatureParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			data, _, err := cliCtx.QueryWithData(route, qp)
+			if err != nil {
+				return err
+			}
+
+			var power types.FeatureSignalPower
+			if err = json.Unmarshal(data, &power); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(power)
+		},
+	}
+}
+
+// GetFeatureHistory implements the history query command.
+func GetFeatureHistory(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "history [feature]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "show history of feature changes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query changes of feature config and support with proposal id and height, in order.
+Example:
+$ %[1]s query featuremanager history
+$ %[1]s query featuremanager history DynamicCheckpoint
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var feature string
+			if len(args) == 1 {
+				feature = args[0]
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureHistory)
+
+			qp, err := cliCtx.Codec.MarshalJSON(types.NewQueryFeatureHistoryParams(feature))
+			if err != nil {
+				return err
+			}
+
+			data, _, err := cliCtx.QueryWithData(route, qp)
+			if err != nil {
+				return err
+			}
+
+			var records types.FeatureChangeRecords
+			if err = json.Unmarshal(data, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
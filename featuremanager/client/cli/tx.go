@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -34,6 +36,7 @@ func GetTxCmd(cdc *codec.Codec, pcmds []*cobra.Command) *cobra.Command {
 
 	govTxCmd.AddCommand(client.PostCommands(
 		cmdSubmitProp,
+		GetCmdSignalFeatures(cdc),
 	)...)
 
 	return govTxCmd
@@ -51,16 +54,13 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 The proposal details must be supplied via a JSON file. For values that contains
 objects, only non-empty fields will be updated.
 
-IMPORTANT: Currently feature change changes are evaluated but not validated, so it is
-very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
-
-Proper vetting of a feature change proposal should prevent this from happening
-(no deposits should occur during the governance process), but it should be noted
-regardless.
+Keys in "int_conf" and "string_conf" of each feature are validated against the
+schema registered for that feature; unknown keys, keys of the wrong type and values
+out of range are rejected. Check accepted keys with:
+$ %[1]s query featuremanager feature-schemas
 
 Example:
-$ %s tx featuremanager feature-change <path/to/proposal.json> --validator-id <validator_id> --chain-id <chain_id>
+$ %[1]s tx featuremanager feature-change <path/to/proposal.json> --validator-id <validator_id> --chain-id <chain_id>
 
 Where proposal.json contains:
 
@@ -125,3 +125,69 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSignalFeatures implements a command handler for signalling features supported by validator.
+func GetCmdSignalFeatures(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-features [feature...]",
+		Short: "Signal features supported by binary of validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Signal features supported by binary of validator. Without args, features
+registered by the connected node are signalled, so point --node at the validator's own node.
+A new signal replaces the previous one of the validator.
+
+Example:
+$ %[1]s tx featuremanager signal-features --validator-id <validator_id> --chain-id <chain_id>
+$ %[1]s tx featuremanager signal-features DynamicCheckpoint FinalizedEth --validator-id <validator_id> --chain-id <chain_id>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("valid validator ID required")
+			}
+
+			features := args
+			if len(features) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureSchemas)
+
+				data, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var schemas types.FeatureSchemas
+				if err = json.Unmarshal(data, &schemas); err != nil {
+					return err
+				}
+
+				for feature := range schemas {
+					features = append(features, feature)
+				}
+
+				sort.Strings(features)
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+
+			msg := types.NewMsgSignalFeatures(from, hmTypes.NewValidatorID(validatorID), features)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetCmdSignalFeatures | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
@@ -71,3 +71,125 @@ func queryFeatureMapHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(writer, cliCtx, res)
 	}
 }
+
+func queryPendingFeaturesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(writer, cliCtx, req)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingFeatures)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(writer, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(writer, cliCtx, res)
+	}
+}
+
+func queryFeatureSchemasHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(writer, cliCtx, req)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureSchemas)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(writer, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(writer, cliCtx, res)
+	}
+}
+
+func queryFeatureSignalsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(writer, cliCtx, req)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureSignals)
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(writer, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(writer, cliCtx, res)
+	}
+}
+
+func querySignalPowerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(writer, cliCtx, req)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(req)
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryTargeFeatureParams(vars["target-feature"]))
+		if err != nil {
+			rest.WriteErrorResponse(writer, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySignalPower)
+
+		res, height, err := cliCtx.QueryWithData(route, queryParams)
+		if err != nil {
+			rest.WriteErrorResponse(writer, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(writer, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query feature change history, optionally filtered by feature.
+func queryFeatureHistoryHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(writer, cliCtx, req)
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryFeatureHistoryParams(req.URL.Query().Get("feature")))
+		if err != nil {
+			rest.WriteErrorResponse(writer, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeatureHistory)
+
+		res, height, err := cliCtx.QueryWithData(route, queryParams)
+		if err != nil {
+			rest.WriteErrorResponse(writer, http.StatusInternalServerError, err.Error())
+
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(writer, cliCtx, res)
+	}
+}
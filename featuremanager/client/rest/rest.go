@@ -10,4 +10,9 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/featuremanager/target-feature/{target-feature}", queryTargetFeatureHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/featuremanager/feature-map", queryFeatureMapHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/featuremanager/pending-features", queryPendingFeaturesHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/featuremanager/feature-schemas", queryFeatureSchemasHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/featuremanager/feature-signals", queryFeatureSignalsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/featuremanager/signal-power/{target-feature}", querySignalPowerHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/featuremanager/history", queryFeatureHistoryHandlerFn(cliCtx)).Methods("GET")
 }
@@ -0,0 +1,31 @@
+package featuremanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/featuremanager/types"
+)
+
+// InitGenesis restores feature change history and validator signals.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// records are validated in order, appending keeps their ids and restores the count
+	for _, record := range data.FeatureHistory {
+		if err := keeper.AppendFeatureChange(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, signal := range data.FeatureSignals {
+		if err := keeper.SetFeatureSignal(ctx, signal); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetFeatureHistory(ctx, ""),
+		keeper.GetFeatureSignals(ctx),
+	)
+}
@@ -0,0 +1,101 @@
+package featuremanager_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/featuremanager"
+	"github.com/maticnetwork/heimdall/featuremanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GenesisTestSuite integrate test suite context object
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+// SetupTest setup necessary things for genesis test
+func (suite *GenesisTestSuite) SetupTest() {
+	suite.app = app.Setup(true)
+	suite.ctx = suite.app.BaseApp.NewContext(true, abci.Header{})
+}
+
+// TestGenesisTestSuite
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+// TestInitExportGenesis test import and export genesis state
+func (suite *GenesisTestSuite) TestInitExportGenesis() {
+	t, ctx := suite.T(), suite.ctx
+	keeper := suite.app.FeatureKeeper
+
+	require.NoError(t, keeper.AppendFeatureChange(ctx, types.FeatureChangeRecord{
+		Feature:      "feature-x",
+		NewConfig:    types.PlainFeatureData{IsOpen: true, ActivationHeight: 100, IntConf: map[string]int{"b": 2, "a": 1}},
+		NewSupported: true,
+		ProposalID:   1,
+		Height:       10,
+	}))
+	require.NoError(t, keeper.AppendFeatureChange(ctx, types.FeatureChangeRecord{
+		Feature:    "feature-y",
+		NewConfig:  types.PlainFeatureData{StringConf: map[string]string{"key": "value"}},
+		ProposalID: 2,
+		Height:     20,
+	}))
+	require.NoError(t, keeper.SetFeatureSignal(ctx, types.FeatureSignal{ValidatorID: 1, Features: []string{"feature-x"}, Height: 30}))
+	require.NoError(t, keeper.SetFeatureSignal(ctx, types.FeatureSignal{ValidatorID: 2, Features: []string{"feature-x", "feature-y"}, Height: 31}))
+
+	genesisState := featuremanager.ExportGenesis(ctx, keeper)
+	require.Len(t, genesisState.FeatureHistory, 2)
+	require.Len(t, genesisState.FeatureSignals, 2)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	// import exported json into fresh app
+	module := featuremanager.NewAppModule(keeper)
+	bz := module.ExportGenesis(ctx)
+	require.NoError(t, module.ValidateGenesis(bz))
+
+	newApp := app.Setup(true)
+	newCtx := newApp.BaseApp.NewContext(true, abci.Header{})
+	featuremanager.NewAppModule(newApp.FeatureKeeper).InitGenesis(newCtx, bz)
+
+	require.Equal(t, genesisState, featuremanager.ExportGenesis(newCtx, newApp.FeatureKeeper))
+	require.Equal(t, uint64(2), newApp.FeatureKeeper.GetFeatureHistoryCount(newCtx))
+
+	// history keeps growing from imported count
+	require.NoError(t, newApp.FeatureKeeper.AppendFeatureChange(newCtx, types.FeatureChangeRecord{Feature: "feature-x", ProposalID: 3}))
+	history := newApp.FeatureKeeper.GetFeatureHistory(newCtx, "")
+	require.Len(t, history, 3)
+	require.Equal(t, uint64(2), history[2].ID)
+
+	// missing entry of older genesis is accepted
+	featuremanager.NewAppModule(newApp.FeatureKeeper).InitGenesis(newCtx, nil)
+}
+
+// TestValidateGenesis test validation of genesis state
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	t := suite.T()
+
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+
+	outOfOrder := types.NewGenesisState(types.FeatureChangeRecords{{ID: 1, Feature: "feature-x"}}, nil)
+	require.Error(t, types.ValidateGenesis(outOfOrder))
+
+	noFeature := types.NewGenesisState(types.FeatureChangeRecords{{ID: 0}}, nil)
+	require.Error(t, types.ValidateGenesis(noFeature))
+
+	duplicateSignal := types.NewGenesisState(nil, types.FeatureSignals{
+		{ValidatorID: hmTypes.ValidatorID(1), Features: []string{"feature-x"}},
+		{ValidatorID: hmTypes.ValidatorID(1), Features: []string{"feature-y"}},
+	})
+	require.Error(t, types.ValidateGenesis(duplicateSignal))
+}
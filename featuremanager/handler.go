@@ -1,8 +1,12 @@
 package featuremanager
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/featuremanager/types"
+	"github.com/maticnetwork/heimdall/gov"
 )
 
 func NewHandler(keeper Keeper) sdk.Handler {
@@ -13,6 +17,9 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case types.MsgSubmitProposal:
 			return handleMsgSubmitProposal(ctx, keeper, msg)
 
+		case types.MsgSignalFeatures:
+			return handleMsgSignalFeatures(ctx, keeper, msg)
+
 		default:
 			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
 		}
@@ -21,12 +28,95 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 // handleMsgSubmitProposal will check the proposal format,
 // the proposal content should be checked in side_handler.
-func handleMsgSubmitProposal(ctx sdk.Context, _ Keeper, msg types.MsgSubmitProposal) sdk.Result {
+func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg types.MsgSubmitProposal) sdk.Result {
 	// check content format
 	if err := msg.ValidateBasic(); err != nil {
 		return err.Result()
 	}
 
+	if content, ok := msg.Content.(types.FeatureChangeProposal); ok {
+		if err := checkSignalThreshold(ctx, keeper, content); err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// checkSignalThreshold refuses to open features which are not signalled by enough voting power,
+// it runs on submission and again when passed proposal is executed as signals may change meanwhile.
+func checkSignalThreshold(ctx sdk.Context, keeper Keeper, content types.FeatureChangeProposal) sdk.Error {
+	featureParamMap := keeper.GetFeatureParams(ctx).FeatureParamMap
+
+	for _, change := range content.Changes {
+		var changeMap types.FeatureParams
+		if err := keeper.cdc.UnmarshalJSON([]byte(change.Value), &changeMap); err != nil {
+			return types.ErrInvalidProposalContent(keeper.Codespace(), err.Error())
+		}
+
+		// sorted so error and gas used don't depend on map order
+		for _, feature := range sortedFeatures(changeMap) {
+			featureData := changeMap.FeatureParamMap[feature]
+			if featureData.IsOpen == nil || !*featureData.IsOpen {
+				continue
+			}
+
+			// threshold set in same proposal takes precedence over stored one
+			threshold := featureParamMap[feature].Plainify().SignalThreshold
+			if featureData.SignalThreshold != nil {
+				threshold = *featureData.SignalThreshold
+			}
+
+			if threshold == 0 {
+				continue
+			}
+
+			power := keeper.GetFeatureSignalPower(ctx, feature)
+			if !power.HasThreshold(threshold) {
+				return types.ErrInsufficientSignal(keeper.Codespace(), feature, power, threshold)
+			}
+		}
+	}
+
+	return nil
+}
+
+// handleMsgSignalFeatures stores features supported by binary of validator.
+func handleMsgSignalFeatures(ctx sdk.Context, keeper Keeper, msg types.MsgSignalFeatures) sdk.Result {
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	if _, err := gov.GetValidValidator(ctx, keeper.govKeeper, msg.From, msg.Validator); err != nil {
+		return types.ErrInvalidFeatureSignal(keeper.Codespace(), err.Error()).Result()
+	}
+
+	for _, feature := range msg.Features {
+		if !keeper.HasFeature(feature) {
+			return types.ErrInvalidFeatureSignal(keeper.Codespace(), fmt.Sprintf("unknown feature %s", feature)).Result()
+		}
+	}
+
+	signal := types.FeatureSignal{
+		ValidatorID: msg.Validator,
+		Features:    msg.Features,
+		Height:      uint64(ctx.BlockHeight()),
+	}
+	if err := keeper.SetFeatureSignal(ctx, signal); err != nil {
+		return types.ErrInvalidFeatureSignal(keeper.Codespace(), err.Error()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSignalFeatures,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyFeatures, strings.Join(msg.Features, ",")),
+		),
+	})
+
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
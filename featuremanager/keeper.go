@@ -1,24 +1,49 @@
 package featuremanager
 
 import (
+	"encoding/binary"
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/featuremanager/types"
 	"github.com/maticnetwork/heimdall/gov"
 	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+var (
+	// FeatureStorePrefix prefixes featuremanager records in params store, it differs from `featuremanager/` of param subspace
+	FeatureStorePrefix = []byte(types.ModuleName + ":")
+
+	FeatureSignalKey       = []byte{0x11} // prefix key for features signalled by validators
+	FeatureHistoryKey      = []byte{0x12} // prefix key for feature change records
+	FeatureHistoryCountKey = []byte{0x13} // key for count of feature change records
+)
+
 // Keeper stores all related data.
 type Keeper struct {
 	cdc *codec.Codec
+	// Params store key, records are kept there under FeatureStorePrefix so no new store is mounted
+	storeKey sdk.StoreKey
 	// codespace
 	codespace sdk.CodespaceType
 
 	// gov keeper to handle subprocess of related proposal
 	govKeeper gov.Keeper
 
-	featureTable map[string]bool
+	// staking keeper to weigh feature signals by voting power
+	sk staking.Keeper
+
+	// chain manager keeper to resolve root chains in feature config
+	ck chainmanager.Keeper
+
+	// registered features and schema of their config
+	featureTable types.FeatureSchemas
 
 	// param space
 	paramSpace subspace.Subspace
@@ -27,16 +52,22 @@ type Keeper struct {
 // NewKeeper create new keeper.
 func NewKeeper(
 	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
 	paramSpace subspace.Subspace,
 	govKeeper gov.Keeper,
+	stakingKeeper staking.Keeper,
+	chainKeeper chainmanager.Keeper,
 	codespace sdk.CodespaceType,
 ) Keeper {
 	// create keeper
 	keeper := Keeper{
 		cdc:          cdc,
+		storeKey:     storeKey,
 		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
 		govKeeper:    govKeeper,
-		featureTable: make(map[string]bool),
+		sk:           stakingKeeper,
+		ck:           chainKeeper,
+		featureTable: make(types.FeatureSchemas),
 		codespace:    codespace,
 	}
 	keeper.RegisterFeature()
@@ -44,6 +75,11 @@ func NewKeeper(
 	return keeper
 }
 
+// store returns prefix store holding featuremanager records
+func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), FeatureStorePrefix)
+}
+
 // Codespace returns the codespace.
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
@@ -54,17 +90,23 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
-func (k Keeper) addFeature(feature string) {
-	k.featureTable[feature] = true
+func (k Keeper) addFeature(feature string, schema types.FeatureSchema) {
+	k.featureTable[feature] = schema
+	types.RegisterFeatureSchema(feature, schema)
 }
 
 // RegisterFeature keeps all adden features to vote for change.
 func (k Keeper) RegisterFeature() {
 	// all new type of features should be registered here.
-	k.addFeature("feature-x")
-	k.addFeature(types.DynamicCheckpoint)
-	k.addFeature(types.SupportMapMarshaling)
-	k.addFeature(types.FinalizedEth)
+	k.addFeature("feature-x", types.EmptyFeatureSchema)
+	k.addFeature(types.DynamicCheckpoint, types.DynamicCheckpointSchema)
+	k.addFeature(types.SupportMapMarshaling, types.EmptyFeatureSchema)
+	k.addFeature(types.FinalizedEth, types.EmptyFeatureSchema)
+}
+
+// RegisterUpgrade registers state upgrade applied once at activation height of feature with its name.
+func (k Keeper) RegisterUpgrade(name string) {
+	k.addFeature(name, types.UpgradeFeatureSchema)
 }
 
 func (k Keeper) HasFeature(feature string) bool {
@@ -73,6 +115,11 @@ func (k Keeper) HasFeature(feature string) bool {
 	return ok
 }
 
+// GetFeatureSchemas returns config schemas of all registered features.
+func (k Keeper) GetFeatureSchemas() types.FeatureSchemas {
+	return k.featureTable
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -95,11 +142,36 @@ func (k Keeper) GetFeatureParams(ctx sdk.Context) (params types.FeatureParams) {
 	return
 }
 
+// GetPendingFeatures gets features whose activation or deactivation is scheduled after current height.
+func (k Keeper) GetPendingFeatures(ctx sdk.Context) types.PendingFeatures {
+	height := uint64(ctx.BlockHeight())
+	pendingFeatures := make(types.PendingFeatures, 0)
+
+	for feature, featureData := range k.GetFeatureParams(ctx).FeatureParamMap {
+		plainData := featureData.Plainify()
+		if plainData.IsPendingAt(height) {
+			pendingFeatures = append(pendingFeatures, types.PendingFeature{
+				Feature:          feature,
+				PlainFeatureData: plainData,
+			})
+		}
+	}
+
+	sort.Slice(pendingFeatures, func(i, j int) bool {
+		return pendingFeatures[i].Feature < pendingFeatures[j].Feature
+	})
+
+	return pendingFeatures
+}
+
 // -----------------------------------------------------------------------------
 // Supported Features.
 
-// AddSupportedFeature add the featuremanager module's supported features.
-func (k Keeper) AddSupportedFeature(ctx sdk.Context, feature string) error {
+// AddSupportedFeature add the featuremanager module's supported features,
+// newly supported feature is recorded in history with proposal id.
+func (k Keeper) AddSupportedFeature(ctx sdk.Context, feature string, proposalID uint64) error {
+	oldSupported := k.GetSupportedFeature(ctx).FeatureSupportMap[feature]
+
 	supportedMap := types.FeatureSupport{
 		FeatureSupportMap: map[string]bool{
 			feature: true,
@@ -111,9 +183,25 @@ func (k Keeper) AddSupportedFeature(ctx sdk.Context, feature string) error {
 		return err
 	}
 
-	err = k.paramSpace.Update(ctx, types.KeySupportFeature, supportedMapRawData)
+	if err = k.paramSpace.Update(ctx, types.KeySupportFeature, supportedMapRawData); err != nil {
+		return err
+	}
+
+	if oldSupported {
+		return nil
+	}
+
+	config := k.GetFeatureParams(ctx).FeatureParamMap[feature].Plainify()
 
-	return err
+	return k.AppendFeatureChange(ctx, types.FeatureChangeRecord{
+		Feature:      feature,
+		OldConfig:    config,
+		NewConfig:    config,
+		OldSupported: false,
+		NewSupported: true,
+		ProposalID:   proposalID,
+		Height:       uint64(ctx.BlockHeight()),
+	})
 }
 
 // GetSupportedFeature gets the featuremanager module's all supported features.
@@ -127,3 +215,153 @@ func (k Keeper) GetSupportedFeature(ctx sdk.Context) (params types.FeatureSuppor
 
 	return
 }
+
+// -----------------------------------------------------------------------------
+// Feature signals.
+
+// GetFeatureSignalKey returns key of feature signal of validator.
+func GetFeatureSignalKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(FeatureSignalKey, validatorID.Bytes()...)
+}
+
+// SetFeatureSignal stores features signalled by validator, replacing earlier signal.
+func (k Keeper) SetFeatureSignal(ctx sdk.Context, signal types.FeatureSignal) error {
+	store := k.store(ctx)
+
+	bz, err := k.cdc.MarshalBinaryBare(signal)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetFeatureSignalKey(signal.ValidatorID), bz)
+
+	return nil
+}
+
+// GetFeatureSignal returns features signalled by validator.
+func (k Keeper) GetFeatureSignal(ctx sdk.Context, validatorID hmTypes.ValidatorID) (signal types.FeatureSignal, ok bool) {
+	store := k.store(ctx)
+
+	bz := store.Get(GetFeatureSignalKey(validatorID))
+	if bz == nil {
+		return signal, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &signal); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling feature signal", "validatorID", validatorID, "error", err)
+
+		return signal, false
+	}
+
+	return signal, true
+}
+
+// GetFeatureSignals returns features signalled by all validators.
+func (k Keeper) GetFeatureSignals(ctx sdk.Context) types.FeatureSignals {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, FeatureSignalKey)
+
+	defer iterator.Close()
+
+	signals := make(types.FeatureSignals, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var signal types.FeatureSignal
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &signal); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling feature signal", "error", err)
+
+			continue
+		}
+
+		signals = append(signals, signal)
+	}
+
+	return signals
+}
+
+// GetFeatureSignalPower returns voting power of current validators which signalled feature.
+func (k Keeper) GetFeatureSignalPower(ctx sdk.Context, feature string) types.FeatureSignalPower {
+	power := types.FeatureSignalPower{
+		Feature:    feature,
+		Validators: make([]hmTypes.ValidatorID, 0),
+	}
+
+	for _, validator := range k.sk.GetCurrentValidators(ctx) {
+		power.TotalPower += validator.VotingPower
+
+		if signal, ok := k.GetFeatureSignal(ctx, validator.ID); ok && signal.HasFeature(feature) {
+			power.SignalledPower += validator.VotingPower
+			power.Validators = append(power.Validators, validator.ID)
+		}
+	}
+
+	return power
+}
+
+// -----------------------------------------------------------------------------
+// Feature change history.
+
+// GetFeatureHistoryKey returns key of feature change record.
+func GetFeatureHistoryKey(id uint64) []byte {
+	return append(FeatureHistoryKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFeatureHistoryCount returns number of feature change records.
+func (k Keeper) GetFeatureHistoryCount(ctx sdk.Context) uint64 {
+	store := k.store(ctx)
+
+	bz := store.Get(FeatureHistoryCountKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// AppendFeatureChange appends record to feature change history, record id is assigned here.
+func (k Keeper) AppendFeatureChange(ctx sdk.Context, record types.FeatureChangeRecord) error {
+	store := k.store(ctx)
+
+	record.ID = k.GetFeatureHistoryCount(ctx)
+
+	// configs hold maps which amino binary can't encode, records are kept as sorted json
+	bz, err := k.cdc.MarshalJSON(record)
+	if err != nil {
+		return err
+	}
+
+	bz, err = sdk.SortJSON(bz)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetFeatureHistoryKey(record.ID), bz)
+	store.Set(FeatureHistoryCountKey, sdk.Uint64ToBigEndian(record.ID+1))
+
+	return nil
+}
+
+// GetFeatureHistory returns feature change records in order, empty feature returns records of all features.
+func (k Keeper) GetFeatureHistory(ctx sdk.Context, feature string) types.FeatureChangeRecords {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, FeatureHistoryKey)
+
+	defer iterator.Close()
+
+	records := make(types.FeatureChangeRecords, 0)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.FeatureChangeRecord
+		if err := k.cdc.UnmarshalJSON(iterator.Value(), &record); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling feature change record", "error", err)
+
+			continue
+		}
+
+		if feature == "" || record.Feature == feature {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,213 @@
+package featuremanager_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/featuremanager"
+	"github.com/maticnetwork/heimdall/featuremanager/types"
+	featuremanagerUtil "github.com/maticnetwork/heimdall/featuremanager/util"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/params"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 50})
+
+	suite.app.FeatureKeeper.SetFeatureParams(suite.ctx, types.DefaultFeatureParams())
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// submitFeatureChange applies feature change proposal as gov does for passed proposal
+func (suite *KeeperTestSuite) submitFeatureChange(ctx sdk.Context, proposalID uint64, value string) sdk.Error {
+	handler := featuremanager.NewFeatureChangeProposalHandler(&suite.app.FeatureKeeper, params.NewParamChangeProposalHandler(suite.app.ParamsKeeper))
+	proposal := types.NewFeatureChangeProposal("title", "description", []types.FeatureChange{types.NewFeatureChange(value)})
+
+	return handler(govTypes.WithProposalID(ctx, proposalID), proposal)
+}
+
+func (suite *KeeperTestSuite) TestActivationHeights() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.FeatureKeeper
+
+	require.NoError(t, keeper.AddSupportedFeature(ctx, "feature-x", 1))
+	require.Nil(t, suite.submitFeatureChange(ctx, 2,
+		`{"feature_param_map":{"feature-x":{"is_open":true,"activation_height":"100","deactivation_height":"200"}}}`))
+
+	config := featuremanagerUtil.GetFeatureConfig()
+	for height, active := range map[int64]bool{99: false, 100: true, 199: true, 200: false} {
+		require.Equal(t, active, config.GetFeature(ctx.WithBlockHeight(height), "feature-x").IsOpen, "height %v", height)
+		require.Equal(t, active, config.GetSupportedFeature(ctx.WithBlockHeight(height), "feature-x"), "height %v", height)
+	}
+
+	// raw config doesn't depend on height
+	require.Equal(t, uint64(100), config.GetRawFeature(ctx.WithBlockHeight(300), "feature-x").ActivationHeight)
+
+	pending := keeper.GetPendingFeatures(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, "feature-x", pending[0].Feature)
+	require.Empty(t, keeper.GetPendingFeatures(ctx.WithBlockHeight(200)))
+}
+
+func (suite *KeeperTestSuite) TestFeatureChangeValidation() {
+	t, ctx := suite.T(), suite.ctx
+
+	testCases := map[string]string{
+		"HeightsOutOfOrder": `{"feature_param_map":{"feature-x":{"is_open":true,"activation_height":"200","deactivation_height":"100"}}}`,
+		"ThresholdOver100":  `{"feature_param_map":{"feature-x":{"is_open":false,"signal_threshold":"101"}}}`,
+		"UnknownConfig":     `{"feature_param_map":{"feature-x":{"is_open":true,"int_conf":{"foo":"1"}}}}`,
+		"OutOfRange":        `{"feature_param_map":{"DynamicCheckpoint":{"is_open":true,"int_conf":{"maxLength":"0"}}}}`,
+		"UnknownRootChain":  `{"feature_param_map":{"DynamicCheckpoint":{"is_open":true,"int_conf":{"polygon":"1"}}}}`,
+	}
+
+	for name, value := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.NotNil(t, suite.submitFeatureChange(ctx, 1, value))
+		})
+	}
+
+	t.Run("RegisteredRootChain", func(t *testing.T) {
+		_, err := suite.app.ChainKeeper.AllocateRootChainID(ctx, "polygon")
+		require.NoError(t, err)
+		require.Nil(t, suite.submitFeatureChange(ctx, 1,
+			`{"feature_param_map":{"DynamicCheckpoint":{"is_open":true,"int_conf":{"maxLength":"1024","polygon":"1"}}}}`))
+	})
+}
+
+func (suite *KeeperTestSuite) TestUpgradeValidation() {
+	t, ctx := suite.T(), suite.ctx
+	suite.app.FeatureKeeper.RegisterUpgrade("upgrade-x")
+
+	// upgrade must be scheduled after current height
+	require.NotNil(t, suite.submitFeatureChange(ctx, 1, `{"feature_param_map":{"upgrade-x":{"is_open":true}}}`))
+	require.NotNil(t, suite.submitFeatureChange(ctx, 1, `{"feature_param_map":{"upgrade-x":{"is_open":true,"activation_height":"50"}}}`))
+	require.Nil(t, suite.submitFeatureChange(ctx, 1, `{"feature_param_map":{"upgrade-x":{"is_open":true,"activation_height":"60"}}}`))
+
+	// pending upgrade can be rescheduled
+	require.Nil(t, suite.submitFeatureChange(ctx, 2, `{"feature_param_map":{"upgrade-x":{"activation_height":"70"}}}`))
+	require.NotNil(t, suite.submitFeatureChange(ctx, 3, `{"feature_param_map":{"upgrade-x":{"activation_height":"40"}}}`))
+
+	// applied upgrade can't be changed
+	require.NotNil(t, suite.submitFeatureChange(ctx.WithBlockHeight(70), 4, `{"feature_param_map":{"upgrade-x":{"activation_height":"80"}}}`))
+	require.NotNil(t, suite.submitFeatureChange(ctx.WithBlockHeight(70), 4, `{"feature_param_map":{"upgrade-x":{"is_open":false}}}`))
+}
+
+func (suite *KeeperTestSuite) TestFeatureHistory() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.FeatureKeeper
+
+	require.NoError(t, keeper.AddSupportedFeature(ctx, "feature-x", 1))
+	// supporting feature again is not a change
+	require.NoError(t, keeper.AddSupportedFeature(ctx, "feature-x", 2))
+
+	require.Nil(t, suite.submitFeatureChange(ctx.WithBlockHeight(60), 3,
+		`{"feature_param_map":{"feature-x":{"is_open":true},"DynamicCheckpoint":{"is_open":true,"int_conf":{"maxLength":"1024"}}}}`))
+
+	history := keeper.GetFeatureHistory(ctx, "")
+	require.Len(t, history, 3)
+
+	for i, record := range history {
+		require.Equal(t, uint64(i), record.ID)
+	}
+
+	require.Equal(t, "feature-x", history[0].Feature)
+	require.False(t, history[0].OldSupported)
+	require.True(t, history[0].NewSupported)
+	require.Equal(t, uint64(1), history[0].ProposalID)
+
+	// changes of one proposal are recorded in order of feature name
+	require.Equal(t, types.DynamicCheckpoint, history[1].Feature)
+	require.Equal(t, 1024, history[1].NewConfig.IntConf["maxLength"])
+	require.Equal(t, "feature-x", history[2].Feature)
+	require.False(t, history[2].OldConfig.IsOpen)
+	require.True(t, history[2].NewConfig.IsOpen)
+	require.Equal(t, uint64(3), history[2].ProposalID)
+	require.Equal(t, uint64(60), history[2].Height)
+
+	require.Len(t, keeper.GetFeatureHistory(ctx, "feature-x"), 2)
+	require.Equal(t, uint64(3), keeper.GetFeatureHistoryCount(ctx))
+
+	// records are kept in params store, no featuremanager store is mounted
+	require.Nil(t, suite.app.GetKey(types.StoreKey))
+	paramsStore := ctx.KVStore(suite.app.GetKey(paramsTypes.StoreKey))
+	require.NotNil(t, paramsStore.Get(append(featuremanager.FeatureStorePrefix, featuremanager.FeatureHistoryCountKey...)))
+}
+
+func (suite *KeeperTestSuite) TestFeatureSignals() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.FeatureKeeper
+	accounts, validators := chSim.LoadAccountValidators(2, t, suite.app.StakingKeeper, ctx)
+	require.NoError(t, suite.app.StakingKeeper.UpdateValidatorSetInStore(ctx, *hmTypes.NewValidatorSet(validators)))
+
+	handler := featuremanager.NewHandler(keeper)
+	signal := func(i int, features ...string) sdk.Result {
+		return handler(ctx, types.NewMsgSignalFeatures(hmTypes.BytesToHeimdallAddress(accounts[i].Address.Bytes()), validators[i].ID, features))
+	}
+
+	t.Run("UnknownFeature", func(t *testing.T) {
+		require.False(t, signal(0, "feature-x", "feature-y").IsOK())
+		_, ok := keeper.GetFeatureSignal(ctx, validators[0].ID)
+		require.False(t, ok)
+	})
+
+	t.Run("WrongSigner", func(t *testing.T) {
+		msg := types.NewMsgSignalFeatures(hmTypes.BytesToHeimdallAddress(accounts[1].Address.Bytes()), validators[0].ID, []string{"feature-x"})
+		require.False(t, handler(ctx, msg).IsOK())
+	})
+
+	t.Run("Threshold", func(t *testing.T) {
+		require.True(t, signal(0, "feature-x").IsOK())
+
+		power := keeper.GetFeatureSignalPower(ctx, "feature-x")
+		require.Equal(t, int64(100), power.SignalledPower)
+		require.Equal(t, int64(200), power.TotalPower)
+
+		require.Nil(t, suite.submitFeatureChange(ctx, 1, `{"feature_param_map":{"feature-x":{"is_open":false,"signal_threshold":"60"}}}`))
+
+		// half of power is below threshold, neither submission nor execution opens feature
+		openFeature := types.NewFeatureChangeProposal("title", "description",
+			[]types.FeatureChange{types.NewFeatureChange(`{"feature_param_map":{"feature-x":{"is_open":true}}}`)})
+		submitMsg := types.NewMsgSubmitProposal(openFeature, sdk.Coins{}, hmTypes.BytesToHeimdallAddress(accounts[0].Address.Bytes()), validators[0].ID)
+		require.False(t, handler(ctx, submitMsg).IsOK())
+		require.NotNil(t, suite.submitFeatureChange(ctx, 2, `{"feature_param_map":{"feature-x":{"is_open":true}}}`))
+
+		// signal withdrawn after submission is checked again on execution
+		require.True(t, signal(1, "feature-x").IsOK())
+		require.True(t, handler(ctx, submitMsg).IsOK())
+		require.True(t, signal(1).IsOK())
+		require.NotNil(t, suite.submitFeatureChange(ctx, 2, `{"feature_param_map":{"feature-x":{"is_open":true}}}`))
+
+		require.True(t, signal(1, "feature-x").IsOK())
+		require.Nil(t, suite.submitFeatureChange(ctx, 2, `{"feature_param_map":{"feature-x":{"is_open":true}}}`))
+		require.True(t, featuremanagerUtil.GetFeatureConfig().GetFeature(ctx, "feature-x").IsOpen)
+	})
+
+	t.Run("SortedThresholdCheck", func(t *testing.T) {
+		// every feature is below threshold, first one in sorted order is always reported
+		openFeatures := types.NewFeatureChangeProposal("title", "description", []types.FeatureChange{types.NewFeatureChange(
+			`{"feature_param_map":{"feature-d":{"is_open":true,"signal_threshold":"60"},"feature-b":{"is_open":true,"signal_threshold":"60"},"feature-c":{"is_open":true,"signal_threshold":"60"}}}`)})
+		submitMsg := types.NewMsgSubmitProposal(openFeatures, sdk.Coins{}, hmTypes.BytesToHeimdallAddress(accounts[0].Address.Bytes()), validators[0].ID)
+
+		for i := 0; i < 10; i++ {
+			result := handler(ctx, submitMsg)
+			require.False(t, result.IsOK())
+			require.Contains(t, result.Log, "feature feature-b is signalled")
+		}
+	})
+}
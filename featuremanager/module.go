@@ -38,10 +38,19 @@ func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 }
 
 // DefaultGenesis default genesis state.
-func (AppModuleBasic) DefaultGenesis() json.RawMessage { return nil }
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
 
 // ValidateGenesis module validate genesis.
-func (AppModuleBasic) ValidateGenesis(_ json.RawMessage) error { return nil }
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+
+	return types.ValidateGenesis(data)
+}
 
 // VerifyGenesis module.
 func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error { return nil }
@@ -79,6 +88,15 @@ func NewAppModule(keeper Keeper) AppModule {
 
 // InitGenesis performs genesis initialization.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	// genesis files exported before featuremanager had genesis state have no entry
+	if len(data) == 0 {
+		return []abci.ValidatorUpdate{}
+	}
+
+	var genesisState types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -112,7 +130,8 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 
 // ExportGenesis returns the exported genesis state as raw bytes.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	return nil
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
 // BeginBlock returns the begin blocker for the auth module.
@@ -0,0 +1,153 @@
+package featuremanager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/heimdall/featuremanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+)
+
+// NewFeatureChangeProposalHandler wraps handler applying feature change proposals
+// and records every changed feature in history. Keeper is taken by pointer as it
+// is created after gov router.
+func NewFeatureChangeProposalHandler(keeper *Keeper, handler govTypes.Handler) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		proposal, ok := content.(types.FeatureChangeProposal)
+		if !ok {
+			return handler(ctx, content)
+		}
+
+		if err := keeper.validateRootChainKeys(ctx, proposal.Changes); err != nil {
+			return err
+		}
+
+		if err := keeper.validateUpgrades(ctx, proposal.Changes); err != nil {
+			return err
+		}
+
+		if err := checkSignalThreshold(ctx, *keeper, proposal); err != nil {
+			return err
+		}
+
+		oldParamMap := keeper.GetFeatureParams(ctx).FeatureParamMap
+
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+
+		proposalID, _ := govTypes.ProposalIDFromContext(ctx)
+		newParamMap := keeper.GetFeatureParams(ctx).FeatureParamMap
+		supportMap := keeper.GetSupportedFeature(ctx).FeatureSupportMap
+
+		features := make(map[string]bool)
+
+		for _, change := range proposal.Changes {
+			var changeMap types.FeatureParams
+			if err := keeper.cdc.UnmarshalJSON([]byte(change.Value), &changeMap); err != nil {
+				return types.ErrInvalidProposalContent(keeper.Codespace(), err.Error())
+			}
+
+			for _, feature := range sortedFeatures(changeMap) {
+				if features[feature] {
+					continue
+				}
+
+				features[feature] = true
+
+				record := types.FeatureChangeRecord{
+					Feature:      feature,
+					OldConfig:    oldParamMap[feature].Plainify(),
+					NewConfig:    newParamMap[feature].Plainify(),
+					OldSupported: supportMap[feature],
+					NewSupported: supportMap[feature],
+					ProposalID:   proposalID,
+					Height:       uint64(ctx.BlockHeight()),
+				}
+				if err := keeper.AppendFeatureChange(ctx, record); err != nil {
+					return types.ErrSettingParameter(keeper.Codespace(), string(types.KeyFeatureParams), change.Value, err.Error())
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// validateRootChainKeys checks that root chain keys of feature config name chains registered in chain manager.
+func (k *Keeper) validateRootChainKeys(ctx sdk.Context, changes []types.FeatureChange) sdk.Error {
+	for _, change := range changes {
+		var changeMap types.FeatureParams
+		if err := k.cdc.UnmarshalJSON([]byte(change.Value), &changeMap); err != nil {
+			return types.ErrInvalidProposalContent(k.Codespace(), err.Error())
+		}
+
+		for _, feature := range sortedFeatures(changeMap) {
+			schema, ok := k.featureTable[feature]
+			if !ok {
+				continue
+			}
+
+			for _, rootChain := range schema.RootChainKeys(changeMap.FeatureParamMap[feature]) {
+				if k.ck.GetRootChainID(ctx, rootChain) == 0 {
+					return types.ErrInvalidProposalContent(k.Codespace(), fmt.Sprintf("%s: unknown root chain %s", feature, rootChain))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateUpgrades checks that upgrade features are scheduled after current height
+// and are not changed once applied, so every upgrade runs exactly once.
+func (k *Keeper) validateUpgrades(ctx sdk.Context, changes []types.FeatureChange) sdk.Error {
+	height := uint64(ctx.BlockHeight())
+	paramMap := k.GetFeatureParams(ctx).FeatureParamMap
+
+	for _, change := range changes {
+		var changeMap types.FeatureParams
+		if err := k.cdc.UnmarshalJSON([]byte(change.Value), &changeMap); err != nil {
+			return types.ErrInvalidProposalContent(k.Codespace(), err.Error())
+		}
+
+		for _, feature := range sortedFeatures(changeMap) {
+			if !k.featureTable[feature].Upgrade {
+				continue
+			}
+
+			current := paramMap[feature].Plainify()
+			if current.IsOpen && current.ActivationHeight <= height {
+				return types.ErrInvalidProposalContent(k.Codespace(), fmt.Sprintf("%s: upgrade already applied at height %v", feature, current.ActivationHeight))
+			}
+
+			// fields missing in change keep current values
+			next, change := current, changeMap.FeatureParamMap[feature]
+			if change.IsOpen != nil {
+				next.IsOpen = *change.IsOpen
+			}
+			if change.ActivationHeight != nil {
+				next.ActivationHeight = *change.ActivationHeight
+			}
+
+			if next.IsOpen && next.ActivationHeight <= height {
+				return types.ErrInvalidProposalContent(k.Codespace(), fmt.Sprintf("%s: upgrade height %v must be after current height %v", feature, next.ActivationHeight, height))
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortedFeatures returns features of params in deterministic order.
+func sortedFeatures(params types.FeatureParams) []string {
+	features := make([]string, 0, len(params.FeatureParamMap))
+	for feature := range params.FeatureParamMap {
+		features = append(features, feature)
+	}
+
+	sort.Strings(features)
+
+	return features
+}
@@ -22,6 +22,21 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case types.QuerySupportedFeatures:
 			return querySupportedFeatures(ctx, keeper)
 
+		case types.QueryPendingFeatures:
+			return queryPendingFeatures(ctx, keeper)
+
+		case types.QueryFeatureSchemas:
+			return queryFeatureSchemas(keeper)
+
+		case types.QueryFeatureSignals:
+			return queryFeatureSignals(ctx, keeper)
+
+		case types.QuerySignalPower:
+			return querySignalPower(ctx, req, keeper)
+
+		case types.QueryFeatureHistory:
+			return queryFeatureHistory(ctx, req, keeper)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown featuremanager query endpoint")
 		}
@@ -41,7 +56,9 @@ func queryTargetFeature(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 		rawData = types.FeatureData{} //nolint: exhaustivestruct
 	}
 
+	// bridge reads IsOpen, evaluate it at query height
 	feature := rawData.Plainify()
+	feature.IsOpen = feature.IsActiveAt(uint64(ctx.BlockHeight()))
 
 	bz, err := json.Marshal(feature)
 	if err != nil {
@@ -68,3 +85,58 @@ func querySupportedFeatures(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error)
 
 	return data, nil
 }
+
+func queryPendingFeatures(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	data, err := json.Marshal(keeper.GetPendingFeatures(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return data, nil
+}
+
+func queryFeatureSchemas(keeper Keeper) ([]byte, sdk.Error) {
+	data, err := json.Marshal(keeper.GetFeatureSchemas())
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return data, nil
+}
+
+func queryFeatureSignals(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	data, err := json.Marshal(keeper.GetFeatureSignals(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return data, nil
+}
+
+func querySignalPower(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTargetFeatureParam
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	data, err := json.Marshal(keeper.GetFeatureSignalPower(ctx, params.TargetFeature))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return data, nil
+}
+
+func queryFeatureHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFeatureHistoryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	data, err := json.Marshal(keeper.GetFeatureHistory(ctx, params.Feature))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return data, nil
+}
@@ -104,7 +104,15 @@ func PostHandleMsgSubmitProposal(ctx sdk.Context, keeper Keeper,
 		return common.ErrSideTxValidation(keeper.Codespace()).Result()
 	}
 
-	var err error
+	// proposal is stored by gov under next proposal id
+	proposalID, err := keeper.govKeeper.GetProposalID(ctx)
+	if err != nil {
+		logger.Error("Unable to get next proposal id", "error", err)
+
+		return err.Result()
+	}
+
+	var addErr error
 	// add all supported features to featuremanager.
 	if content, ok := msg.Content.(types.FeatureChangeProposal); ok {
 	Changes:
@@ -114,8 +122,8 @@ func PostHandleMsgSubmitProposal(ctx sdk.Context, keeper Keeper,
 			var changeMap types.FeatureParams
 			_ = keeper.cdc.UnmarshalJSON([]byte(chagneMapStr), &changeMap)
 
-			for key := range changeMap.FeatureParamMap {
-				if err = keeper.AddSupportedFeature(ctx, key); err != nil {
+			for _, key := range sortedFeatures(changeMap) {
+				if addErr = keeper.AddSupportedFeature(ctx, key, proposalID); addErr != nil {
 					break Changes
 				}
 			}
@@ -127,8 +135,8 @@ func PostHandleMsgSubmitProposal(ctx sdk.Context, keeper Keeper,
 	}
 
 	// if meets any error, cancel transfer this proposal to gov.
-	if err != nil {
-		logger.Error("Save supported features failed.", "error", err)
+	if addErr != nil {
+		logger.Error("Save supported features failed.", "error", addErr)
 
 		return common.ErrSideTxValidation(keeper.Codespace()).Result()
 	}
@@ -15,4 +15,5 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "featuremanager/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(FeatureChangeProposal{}, "featuremanager/FeatureChangeProposal", nil)
+	cdc.RegisterConcrete(MsgSignalFeatures{}, "featuremanager/MsgSignalFeatures", nil)
 }
@@ -25,6 +25,8 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 12
 	CodeInvalidProposalStatus    sdk.CodeType = 13
 	CodeProposalHandlerNotExists sdk.CodeType = 14
+	CodeInvalidFeatureSignal     sdk.CodeType = 15
+	CodeInsufficientSignal       sdk.CodeType = 16
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -71,3 +73,12 @@ func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf(
 		"'%T' does not have a corresponding handler", content))
 }
+
+func ErrInvalidFeatureSignal(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeatureSignal, fmt.Sprintf("invalid feature signal: %s", msg))
+}
+
+func ErrInsufficientSignal(codespace sdk.CodespaceType, feature string, power FeatureSignalPower, threshold uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientSignal, fmt.Sprintf(
+		"feature %s is signalled by power %v of %v, %v%% required", feature, power.SignalledPower, power.TotalPower, threshold))
+}
@@ -0,0 +1,11 @@
+package types
+
+// Feature manager tags
+var (
+	EventTypeSignalFeatures = "signal-features"
+
+	AttributeKeyValidatorID = "validator-id"
+	AttributeKeyFeatures    = "features"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GenesisState - featuremanager records that must be kept across genesis export
+type GenesisState struct {
+	FeatureHistory FeatureChangeRecords `json:"feature_history" yaml:"feature_history"`
+	FeatureSignals FeatureSignals       `json:"feature_signals" yaml:"feature_signals"`
+}
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(featureHistory FeatureChangeRecords, featureSignals FeatureSignals) GenesisState {
+	return GenesisState{
+		FeatureHistory: featureHistory,
+		FeatureSignals: featureSignals,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(FeatureChangeRecords{}, FeatureSignals{})
+}
+
+// ValidateGenesis performs basic validation of featuremanager genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	// history is append-only, ids follow record order
+	for i, record := range data.FeatureHistory {
+		if record.ID != uint64(i) {
+			return fmt.Errorf("feature change record %v out of order, expected id %v", record.ID, i)
+		}
+
+		if record.Feature == "" {
+			return fmt.Errorf("feature change record %v has no feature", record.ID)
+		}
+	}
+
+	validators := make(map[hmTypes.ValidatorID]bool)
+
+	for _, signal := range data.FeatureSignals {
+		if validators[signal.ValidatorID] {
+			return fmt.Errorf("duplicate feature signal of validator %v", signal.ValidatorID)
+		}
+
+		for _, feature := range signal.Features {
+			if feature == "" {
+				return fmt.Errorf("empty feature signalled by validator %v", signal.ValidatorID)
+			}
+		}
+
+		validators[signal.ValidatorID] = true
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns featuremanager GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
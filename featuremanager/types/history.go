@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+)
+
+// FeatureChangeRecord records a change of feature config or support, records are append-only.
+type FeatureChangeRecord struct {
+	ID           uint64           `json:"id" yaml:"id"`
+	Feature      string           `json:"feature" yaml:"feature"`
+	OldConfig    PlainFeatureData `json:"old_config" yaml:"old_config"`
+	NewConfig    PlainFeatureData `json:"new_config" yaml:"new_config"`
+	OldSupported bool             `json:"old_supported" yaml:"old_supported"`
+	NewSupported bool             `json:"new_supported" yaml:"new_supported"`
+	ProposalID   uint64           `json:"proposal_id" yaml:"proposal_id"`
+	Height       uint64           `json:"height" yaml:"height"`
+}
+
+func (fcr FeatureChangeRecord) String() string {
+	return fmt.Sprintf(`
+	ID:				%v,
+	[feature]:		%s
+	ProposalID:		%v,
+	Height:			%v,
+	Supported:		%v -> %v,
+	OldConfig:		%s
+	NewConfig:		%s
+	`, fcr.ID, fcr.Feature, fcr.ProposalID, fcr.Height, fcr.OldSupported, fcr.NewSupported,
+		fcr.OldConfig.String(), fcr.NewConfig.String())
+}
+
+// FeatureChangeRecords list of feature change records.
+type FeatureChangeRecords []FeatureChangeRecord
+
+func (fcrs FeatureChangeRecords) String() string {
+	var ret string
+	for _, fcr := range fcrs {
+		ret += fcr.String()
+	}
+
+	return ret
+}
@@ -1,16 +1,19 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
-var _ sdk.Msg = MsgSubmitProposal{}
+var _, _ sdk.Msg = MsgSubmitProposal{}, MsgSignalFeatures{}
 
 const (
 	TypeMsgSubmitProposal = "submit_feature_proposal"
+	TypeMsgSignalFeatures = "signal_features"
 )
 
 // MsgSubmitProposal represents submit proposal message.
@@ -43,3 +46,66 @@ func (msg MsgSubmitProposal) GetSideSignBytes() []byte {
 	// stakeUpdate:(uint256 validatorId, uint256 nonce, uint256 newAmount)
 	return helper.AppendBytes32()
 }
+
+// MsgSignalFeatures represents features supported by binary of validator.
+type MsgSignalFeatures struct {
+	From      hmTypes.HeimdallAddress `json:"from" yaml:"from"`
+	Validator hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+	Features  []string                `json:"features" yaml:"features"`
+}
+
+// NewMsgSignalFeatures creates new signal features message.
+func NewMsgSignalFeatures(from hmTypes.HeimdallAddress, validator hmTypes.ValidatorID, features []string) MsgSignalFeatures {
+	return MsgSignalFeatures{
+		From:      from,
+		Validator: validator,
+		Features:  features,
+	}
+}
+
+func (msg MsgSignalFeatures) Route() string { return RouterKey }
+func (msg MsgSignalFeatures) Type() string  { return TypeMsgSignalFeatures }
+
+// ValidateBasic checks signer, validator and feature list, features may be empty to clear signal.
+func (msg MsgSignalFeatures) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+
+	if msg.Validator == 0 {
+		return ErrInvalidFeatureSignal(DefaultCodespace, "invalid validator id")
+	}
+
+	seen := make(map[string]bool, len(msg.Features))
+	for _, feature := range msg.Features {
+		if feature == "" {
+			return ErrInvalidFeatureSignal(DefaultCodespace, "empty feature name")
+		}
+
+		if seen[feature] {
+			return ErrInvalidFeatureSignal(DefaultCodespace, fmt.Sprintf("duplicate feature %s", feature))
+		}
+
+		seen[feature] = true
+	}
+
+	return nil
+}
+
+func (msg MsgSignalFeatures) String() string {
+	return fmt.Sprintf(`Signal Features Message:
+  Validator: %s
+  Features:  %v
+`, msg.Validator.String(), msg.Features)
+}
+
+// GetSignBytes returns sign bytes.
+func (msg MsgSignalFeatures) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns signers.
+func (msg MsgSignalFeatures) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
@@ -16,6 +16,13 @@ var (
 type FeatureData struct {
 	IsOpen *bool `json:"is_open" yaml:"is_open"`
 
+	// feature takes effect from activation height until deactivation height, zero means unbounded
+	ActivationHeight   *uint64 `json:"activation_height,omitempty" yaml:"activation_height,omitempty"`
+	DeactivationHeight *uint64 `json:"deactivation_height,omitempty" yaml:"deactivation_height,omitempty"`
+
+	// percent of total voting power which must signal feature before it can be opened, zero means no check
+	SignalThreshold *uint64 `json:"signal_threshold,omitempty" yaml:"signal_threshold,omitempty"`
+
 	// extra data
 	IntConf    map[string]int    `json:"int_conf" yaml:"int_conf"`
 	StringConf map[string]string `json:"string_conf" yaml:"string_conf"`
@@ -25,6 +32,11 @@ type FeatureData struct {
 type PlainFeatureData struct {
 	IsOpen bool `json:"is_open" yaml:"is_open"`
 
+	ActivationHeight   uint64 `json:"activation_height" yaml:"activation_height"`
+	DeactivationHeight uint64 `json:"deactivation_height" yaml:"deactivation_height"`
+
+	SignalThreshold uint64 `json:"signal_threshold" yaml:"signal_threshold"`
+
 	// extra data
 	IntConf    map[string]int    `json:"int_conf" yaml:"int_conf"`
 	StringConf map[string]string `json:"string_conf" yaml:"string_conf"`
@@ -35,21 +47,79 @@ func (fd FeatureData) Plainify() (pd PlainFeatureData) {
 		pd.IsOpen = *fd.IsOpen
 	}
 
+	if fd.ActivationHeight != nil {
+		pd.ActivationHeight = *fd.ActivationHeight
+	}
+
+	if fd.DeactivationHeight != nil {
+		pd.DeactivationHeight = *fd.DeactivationHeight
+	}
+
+	if fd.SignalThreshold != nil {
+		pd.SignalThreshold = *fd.SignalThreshold
+	}
+
 	pd.IntConf = fd.IntConf
 	pd.StringConf = fd.StringConf
 
 	return
 }
 
+// ValidateHeights checks that deactivation height is after activation height.
+func (fd FeatureData) ValidateHeights() error {
+	pd := fd.Plainify()
+	if pd.DeactivationHeight != 0 && pd.DeactivationHeight <= pd.ActivationHeight {
+		return fmt.Errorf("deactivation height %v must be greater than activation height %v",
+			pd.DeactivationHeight, pd.ActivationHeight)
+	}
+
+	return nil
+}
+
+// ValidateSignalThreshold checks that signal threshold is a percent.
+func (fd FeatureData) ValidateSignalThreshold() error {
+	if fd.SignalThreshold != nil && *fd.SignalThreshold > 100 {
+		return fmt.Errorf("signal threshold %v must not be greater than 100", *fd.SignalThreshold)
+	}
+
+	return nil
+}
+
+// IsActiveAt returns true if feature is open and height is within activation window.
+func (pfd PlainFeatureData) IsActiveAt(height uint64) bool {
+	return pfd.IsOpen && pfd.IsWithinHeightsAt(height)
+}
+
+// IsWithinHeightsAt returns true if height is within activation window regardless of feature being open.
+func (pfd PlainFeatureData) IsWithinHeightsAt(height uint64) bool {
+	if height < pfd.ActivationHeight {
+		return false
+	}
+
+	return pfd.DeactivationHeight == 0 || height < pfd.DeactivationHeight
+}
+
+// IsPendingAt returns true if feature activation or deactivation is scheduled after height.
+func (pfd PlainFeatureData) IsPendingAt(height uint64) bool {
+	if !pfd.IsOpen {
+		return false
+	}
+
+	return pfd.ActivationHeight > height || pfd.DeactivationHeight > height
+}
+
 func (pfd PlainFeatureData) String() string {
 	var ret string
 
 	ret += fmt.Sprintf(`
 	IsOpen: 					%v,
+	ActivationHeight:			%v,
+	DeactivationHeight:			%v,
+	SignalThreshold:			%v,
 	IntConf: 					%v,
 	StringConf:					%v
 	`,
-		pfd.IsOpen, pfd.IntConf, pfd.StringConf)
+		pfd.IsOpen, pfd.ActivationHeight, pfd.DeactivationHeight, pfd.SignalThreshold, pfd.IntConf, pfd.StringConf)
 
 	return ret
 }
@@ -82,10 +152,38 @@ func (fp FeatureParams) String() string {
 		ret += fmt.Sprintf(`
 		[feature]: 				%s
 		IsOpen: 				%v,
+		ActivationHeight:		%v,
+		DeactivationHeight:		%v,
+		SignalThreshold:		%v,
 		IntConf: 				%v,
 		StringConf:				%v,				
 		`,
-			key, pVal.IsOpen, pVal.IntConf, pVal.StringConf)
+			key, pVal.IsOpen, pVal.ActivationHeight, pVal.DeactivationHeight, pVal.SignalThreshold,
+			pVal.IntConf, pVal.StringConf)
+	}
+
+	return ret
+}
+
+// PendingFeature is a feature with activation or deactivation scheduled in future.
+// nolint
+type PendingFeature struct {
+	Feature string `json:"feature" yaml:"feature"`
+	PlainFeatureData
+}
+
+func (pf PendingFeature) String() string {
+	return fmt.Sprintf(`
+	[feature]: 					%s%s`, pf.Feature, pf.PlainFeatureData.String())
+}
+
+// PendingFeatures list of pending features.
+type PendingFeatures []PendingFeature
+
+func (pfs PendingFeatures) String() string {
+	var ret string
+	for _, pf := range pfs {
+		ret += pf.String()
 	}
 
 	return ret
@@ -100,6 +100,28 @@ func ValidateChanges(changes []FeatureChange) sdk.Error {
 		if len(pc.Value) == 0 {
 			return ErrEmptyValue(DefaultCodespace)
 		}
+
+		var changeMap FeatureParams
+		if err := ModuleCdc.UnmarshalJSON([]byte(pc.Value), &changeMap); err != nil {
+			return ErrInvalidProposalContent(DefaultCodespace, err.Error())
+		}
+
+		for feature, featureData := range changeMap.FeatureParamMap {
+			if err := featureData.ValidateHeights(); err != nil {
+				return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("%s: %s", feature, err.Error()))
+			}
+
+			if err := featureData.ValidateSignalThreshold(); err != nil {
+				return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("%s: %s", feature, err.Error()))
+			}
+
+			// features unknown to this process are rejected by keeper in side handler
+			if schema, ok := GetFeatureSchema(feature); ok {
+				if err := schema.Validate(featureData); err != nil {
+					return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("%s: %s", feature, err.Error()))
+				}
+			}
+		}
 	}
 
 	return nil
@@ -5,6 +5,11 @@ const (
 	QueryTargetFeature     = "target-feature"
 	QueryFeatureMap        = "feature-map"
 	QuerySupportedFeatures = "supported-features"
+	QueryPendingFeatures   = "pending-features"
+	QueryFeatureSchemas    = "feature-schemas"
+	QueryFeatureSignals    = "feature-signals"
+	QuerySignalPower       = "signal-power"
+	QueryFeatureHistory    = "history"
 )
 
 // QueryChainParams defines the params for querying accounts.
@@ -18,3 +23,15 @@ func NewQueryTargeFeatureParams(targetFeature string) QueryTargetFeatureParam {
 		TargetFeature: targetFeature,
 	}
 }
+
+// QueryFeatureHistoryParams defines the params for querying feature change history.
+type QueryFeatureHistoryParams struct {
+	Feature string `json:"feature"`
+}
+
+// NewQueryFeatureHistoryParams creates a new instance of QueryFeatureHistoryParams, empty feature matches all.
+func NewQueryFeatureHistoryParams(feature string) QueryFeatureHistoryParams {
+	return QueryFeatureHistoryParams{
+		Feature: feature,
+	}
+}
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// IntRange bounds an int config value, both ends inclusive.
+type IntRange struct {
+	Min int `json:"min" yaml:"min"`
+	Max int `json:"max" yaml:"max"`
+}
+
+// FeatureSchema declares config keys a feature accepts in its IntConf and StringConf.
+type FeatureSchema struct {
+	// allowed int keys and their ranges
+	IntConf map[string]IntRange `json:"int_conf" yaml:"int_conf"`
+	// allowed string keys and their allowed values, empty list accepts any value
+	StringConf map[string][]string `json:"string_conf" yaml:"string_conf"`
+	// range for int keys named after a registered root chain, nil if not accepted
+	RootChainIntConf *IntRange `json:"root_chain_int_conf,omitempty" yaml:"root_chain_int_conf,omitempty"`
+	// feature is a state upgrade applied once at its activation height
+	Upgrade bool `json:"upgrade,omitempty" yaml:"upgrade,omitempty"`
+}
+
+// FeatureSchemas feature name to schema.
+type FeatureSchemas map[string]FeatureSchema
+
+// Built-in feature schemas.
+var (
+	// EmptyFeatureSchema accepts no config keys.
+	EmptyFeatureSchema = FeatureSchema{}
+
+	// UpgradeFeatureSchema accepts no config keys, feature is applied once at its activation height.
+	UpgradeFeatureSchema = FeatureSchema{Upgrade: true}
+
+	// DynamicCheckpointSchema enables dynamic checkpoint per root chain with 0 / 1 flags.
+	DynamicCheckpointSchema = FeatureSchema{
+		IntConf: map[string]IntRange{
+			"maxLength": {Min: 1, Max: math.MaxInt32},
+		},
+		RootChainIntConf: &IntRange{Min: 0, Max: 1},
+	}
+)
+
+var (
+	featureSchemaMu sync.RWMutex
+	featureSchemas  = make(FeatureSchemas)
+)
+
+// RegisterFeatureSchema registers config schema of feature.
+func RegisterFeatureSchema(feature string, schema FeatureSchema) {
+	featureSchemaMu.Lock()
+	defer featureSchemaMu.Unlock()
+
+	featureSchemas[feature] = schema
+}
+
+// GetFeatureSchema returns config schema of feature if registered.
+func GetFeatureSchema(feature string) (FeatureSchema, bool) {
+	featureSchemaMu.RLock()
+	defer featureSchemaMu.RUnlock()
+
+	schema, ok := featureSchemas[feature]
+
+	return schema, ok
+}
+
+// Validate checks that every config key of featureData is declared and within bounds.
+func (fs FeatureSchema) Validate(featureData FeatureData) error {
+	for key, value := range featureData.IntConf {
+		intRange, ok := fs.IntConf[key]
+		if !ok && fs.isRootChainKey(key) {
+			intRange, ok = *fs.RootChainIntConf, true
+		}
+
+		if !ok {
+			if _, isString := fs.StringConf[key]; isString {
+				return fmt.Errorf("config %s must be in string_conf", key)
+			}
+
+			return fmt.Errorf("unknown int config %s", key)
+		}
+
+		if value < intRange.Min || value > intRange.Max {
+			return fmt.Errorf("int config %s=%v out of range [%v, %v]", key, value, intRange.Min, intRange.Max)
+		}
+	}
+
+	for key, value := range featureData.StringConf {
+		allowed, ok := fs.StringConf[key]
+		if !ok {
+			if _, isInt := fs.IntConf[key]; isInt {
+				return fmt.Errorf("config %s must be in int_conf", key)
+			}
+
+			return fmt.Errorf("unknown string config %s", key)
+		}
+
+		if len(allowed) != 0 && !containsString(allowed, value) {
+			return fmt.Errorf("string config %s=%s not in %v", key, value, allowed)
+		}
+	}
+
+	return nil
+}
+
+// RootChainKeys returns int config keys of featureData checked against root chain range,
+// they must name a root chain registered in chain manager.
+func (fs FeatureSchema) RootChainKeys(featureData FeatureData) []string {
+	keys := make([]string, 0)
+	for key := range featureData.IntConf {
+		if _, ok := fs.IntConf[key]; !ok && fs.isRootChainKey(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// isRootChainKey reports whether key is left for root chains by schema.
+func (fs FeatureSchema) isRootChainKey(key string) bool {
+	if fs.RootChainIntConf == nil || key == "" {
+		return false
+	}
+
+	_, isString := fs.StringConf[key]
+
+	return !isString
+}
+
+// String implements fmt.Stringer.
+func (fs FeatureSchema) String() string {
+	var builder strings.Builder
+
+	if fs.Upgrade {
+		builder.WriteString("  upgrade applied once at activation height\n")
+	}
+
+	if len(fs.IntConf) == 0 && len(fs.StringConf) == 0 && fs.RootChainIntConf == nil {
+		builder.WriteString("  no config\n")
+
+		return builder.String()
+	}
+
+	intKeys := make([]string, 0, len(fs.IntConf))
+	for key := range fs.IntConf {
+		intKeys = append(intKeys, key)
+	}
+
+	sort.Strings(intKeys)
+
+	for _, key := range intKeys {
+		intRange := fs.IntConf[key]
+		builder.WriteString(fmt.Sprintf("  int_conf.%s: [%v, %v]\n", key, intRange.Min, intRange.Max))
+	}
+
+	if fs.RootChainIntConf != nil {
+		builder.WriteString(fmt.Sprintf("  int_conf.<root chain>: [%v, %v]\n",
+			fs.RootChainIntConf.Min, fs.RootChainIntConf.Max))
+	}
+
+	stringKeys := make([]string, 0, len(fs.StringConf))
+	for key := range fs.StringConf {
+		stringKeys = append(stringKeys, key)
+	}
+
+	sort.Strings(stringKeys)
+
+	for _, key := range stringKeys {
+		if allowed := fs.StringConf[key]; len(allowed) != 0 {
+			builder.WriteString(fmt.Sprintf("  string_conf.%s: %v\n", key, allowed))
+		} else {
+			builder.WriteString(fmt.Sprintf("  string_conf.%s: any\n", key))
+		}
+	}
+
+	return builder.String()
+}
+
+// String implements fmt.Stringer.
+func (fss FeatureSchemas) String() string {
+	var builder strings.Builder
+
+	features := make([]string, 0, len(fss))
+	for feature := range fss {
+		features = append(features, feature)
+	}
+
+	sort.Strings(features)
+
+	for _, feature := range features {
+		builder.WriteString(fmt.Sprintf("%s:\n%s", feature, fss[feature].String()))
+	}
+
+	return strings.TrimSpace(builder.String())
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureSchemaValidate(t *testing.T) {
+	schema := FeatureSchema{
+		IntConf:          map[string]IntRange{"maxLength": {Min: 1, Max: 100}},
+		StringConf:       map[string][]string{"mode": {"fast", "safe"}, "label": {}},
+		RootChainIntConf: &IntRange{Min: 0, Max: 1},
+	}
+
+	require.NoError(t, schema.Validate(FeatureData{
+		IntConf:    map[string]int{"maxLength": 100, "eth": 1, "polygon": 0},
+		StringConf: map[string]string{"mode": "safe", "label": "any"},
+	}))
+
+	testCases := map[string]FeatureData{
+		"IntOutOfRange":       {IntConf: map[string]int{"maxLength": 0}},
+		"RootChainOutOfRange": {IntConf: map[string]int{"eth": 2}},
+		"StringInIntConf":     {IntConf: map[string]int{"mode": 1}},
+		"IntInStringConf":     {StringConf: map[string]string{"maxLength": "1"}},
+		"UnknownString":       {StringConf: map[string]string{"foo": "bar"}},
+		"NotAllowedString":    {StringConf: map[string]string{"mode": "slow"}},
+	}
+
+	for name, featureData := range testCases {
+		require.Error(t, schema.Validate(featureData), name)
+	}
+
+	// empty schema accepts no keys
+	require.Error(t, EmptyFeatureSchema.Validate(FeatureData{IntConf: map[string]int{"eth": 1}}))
+	require.NoError(t, EmptyFeatureSchema.Validate(FeatureData{}))
+}
+
+func TestFeatureSchemaRootChainKeys(t *testing.T) {
+	featureData := FeatureData{IntConf: map[string]int{"maxLength": 10, "tron": 1, "eth": 0}}
+
+	require.Equal(t, []string{"eth", "tron"}, DynamicCheckpointSchema.RootChainKeys(featureData))
+	require.Empty(t, EmptyFeatureSchema.RootChainKeys(featureData))
+}
+
+func TestFeatureDataHeights(t *testing.T) {
+	activation, deactivation := uint64(100), uint64(200)
+	isOpen := true
+	featureData := FeatureData{IsOpen: &isOpen, ActivationHeight: &activation, DeactivationHeight: &deactivation}
+	require.NoError(t, featureData.ValidateHeights())
+
+	config := featureData.Plainify()
+	require.False(t, config.IsActiveAt(99))
+	require.True(t, config.IsActiveAt(100))
+	require.False(t, config.IsActiveAt(200))
+	require.True(t, config.IsPendingAt(150))
+	require.False(t, config.IsPendingAt(200))
+
+	// closed feature is never active though within its heights
+	config.IsOpen = false
+	require.False(t, config.IsActiveAt(150))
+	require.True(t, config.IsWithinHeightsAt(150))
+
+	featureData.DeactivationHeight = &activation
+	require.Error(t, featureData.ValidateHeights())
+}
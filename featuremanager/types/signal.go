@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// FeatureSignal features supported by binary of a validator.
+type FeatureSignal struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	Features    []string            `json:"features" yaml:"features"`
+	Height      uint64              `json:"height" yaml:"height"`
+}
+
+// HasFeature returns true if feature is signalled.
+func (fs FeatureSignal) HasFeature(feature string) bool {
+	for _, f := range fs.Features {
+		if f == feature {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (fs FeatureSignal) String() string {
+	return fmt.Sprintf(`
+	ValidatorID:	%v,
+	Features:		%v,
+	Height:			%v
+	`, fs.ValidatorID, fs.Features, fs.Height)
+}
+
+// FeatureSignals list of validator feature signals.
+type FeatureSignals []FeatureSignal
+
+func (fss FeatureSignals) String() string {
+	var ret string
+	for _, fs := range fss {
+		ret += fs.String()
+	}
+
+	return ret
+}
+
+// FeatureSignalPower voting power of current validators signalled feature.
+type FeatureSignalPower struct {
+	Feature        string                `json:"feature" yaml:"feature"`
+	SignalledPower int64                 `json:"signalled_power" yaml:"signalled_power"`
+	TotalPower     int64                 `json:"total_power" yaml:"total_power"`
+	Validators     []hmTypes.ValidatorID `json:"validators" yaml:"validators"`
+}
+
+// HasThreshold returns true if signalled power reaches threshold percent of total power.
+func (fsp FeatureSignalPower) HasThreshold(threshold uint64) bool {
+	if threshold == 0 {
+		return true
+	}
+
+	return uint64(fsp.SignalledPower)*100 >= uint64(fsp.TotalPower)*threshold
+}
+
+func (fsp FeatureSignalPower) String() string {
+	return fmt.Sprintf(`
+	[feature]: 			%s
+	SignalledPower:		%v,
+	TotalPower:			%v,
+	Validators:			%v
+	`, fsp.Feature, fsp.SignalledPower, fsp.TotalPower, fsp.Validators)
+}
@@ -31,7 +31,8 @@ func InitFeatureConfig(paramSpace subspace.Subspace) {
 	featureManager.featureSpace = paramSpace
 }
 
-// GetFeature is used to get target feature config.
+// GetFeature is used to get target feature config,
+// IsOpen is evaluated against activation and deactivation heights at current block height.
 func (m *FeatureConfig) GetFeature(ctx sdk.Context, feature string) (config featuremanagerTypes.PlainFeatureData) {
 	defer func() {
 		// if featureSpace cannot get FeatureParams, it will recover from here.
@@ -52,13 +53,42 @@ func (m *FeatureConfig) GetFeature(ctx sdk.Context, feature string) (config feat
 		config = featuremanagerTypes.PlainFeatureData{}
 	} else {
 		config = rawData.Plainify()
+		config.IsOpen = config.IsActiveAt(uint64(ctx.BlockHeight()))
 	}
 
 	return
 }
 
-// GetSupportedFeature is used to get whether the target feature is consensus supported feature.
-func (m *FeatureConfig) GetSupportedFeature(ctx sdk.Context, feature string) (isSupported bool) {
+// GetSupportedFeature is used to get whether the target feature is consensus supported feature
+// and current block height is within its activation window.
+func (m *FeatureConfig) GetSupportedFeature(ctx sdk.Context, feature string) bool {
+	if !m.HasSupportedFeature(ctx, feature) {
+		return false
+	}
+
+	return m.GetRawFeature(ctx, feature).IsWithinHeightsAt(uint64(ctx.BlockHeight()))
+}
+
+// GetRawFeature is used to get target feature config without evaluating activation heights.
+func (m *FeatureConfig) GetRawFeature(ctx sdk.Context, feature string) (config featuremanagerTypes.PlainFeatureData) {
+	defer func() {
+		if err := recover(); err != nil {
+			config = featuremanagerTypes.PlainFeatureData{}
+		}
+	}()
+
+	params := featuremanagerTypes.FeatureParams{}
+	m.featureSpace.GetParamSet(ctx, &params)
+
+	if rawData, ok := params.FeatureParamMap[feature]; ok {
+		config = rawData.Plainify()
+	}
+
+	return
+}
+
+// HasSupportedFeature is used to get whether the target feature has been added as supported feature.
+func (m *FeatureConfig) HasSupportedFeature(ctx sdk.Context, feature string) (isSupported bool) {
 	defer func() {
 		// if featureSpace cannot get FeatureSupport, it will recover from here.
 		if err := recover(); err != nil {
@@ -52,7 +52,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
+			err := handler(types.WithProposalID(cacheCtx, proposal.ProposalID), proposal.Content)
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
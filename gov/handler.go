@@ -52,7 +52,8 @@ func HandleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg types.MsgSubmit
 			_ = keeper.cdc.UnmarshalJSON([]byte(chagneMapStr), &changeMap)
 
 			for key := range changeMap.FeatureParamMap {
-				isSupported = featuremanagerUtil.GetFeatureConfig().GetSupportedFeature(ctx, key)
+				// deactivated feature can be opened again by new proposal
+				isSupported = featuremanagerUtil.GetFeatureConfig().HasSupportedFeature(ctx, key)
 				if !isSupported {
 					keeper.Logger(ctx).Info(
 						"Feature is not supported",
@@ -30,6 +30,21 @@ type Content interface {
 // governance process.
 type Handler func(ctx sdk.Context, content Content) sdk.Error
 
+type proposalIDContextKey struct{}
+
+// WithProposalID returns context carrying id of proposal being executed, so
+// handlers can refer to the proposal they apply.
+func WithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(proposalIDContextKey{}, proposalID)
+}
+
+// ProposalIDFromContext returns id of proposal being executed, false if
+// handler is not run for a passed proposal.
+func ProposalIDFromContext(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(proposalIDContextKey{}).(uint64)
+	return proposalID, ok
+}
+
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
 func ValidateAbstract(codespace sdk.CodespaceType, c Content) sdk.Error {
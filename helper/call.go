@@ -41,6 +41,7 @@ var ContractsABIsMap = make(map[string]*abi.ABI)
 type IContractCaller interface {
 	GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
 	GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error)
+	GetMaticChainBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*ethTypes.Header, error)
 	GetValidatorInfo(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error)
 	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
 	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
@@ -50,6 +51,7 @@ type IContractCaller interface {
 	SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (err error)
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int, string) (*ethTypes.Header, error)
+	GetMainChainGasPrice(rootChain string) (*big.Int, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetConfirmedTxReceipt(common.Hash, uint64, string, bool) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
@@ -62,6 +64,8 @@ type IContractCaller interface {
 	DecodeValidatorStakeUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStakeUpdate, error)
 	DecodeValidatorExitEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnstakeInit, error)
 	DecodeSignerUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSignerChange, error)
+	DecodeShareMintedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareMinted, error)
+	DecodeShareBurnedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareBurned, error)
 	// decode state events
 	DecodeStateSyncedEvent(common.Address, *ethTypes.Receipt, uint64) (*statesender.StatesenderStateSynced, error)
 
@@ -100,13 +104,17 @@ type IContractCaller interface {
 	GetTronEventsByContractAddress(address []string, from, to int64) ([]ethTypes.Log, error)
 	GetTronTransactionReceipt(txID string) (*ethTypes.Receipt, error)
 	GetTronLatestBlockNumber() (int64, error)
+	GetTronEnergyFee() (int64, error)
 
 	// checkpoint sync
-	GetSyncedCheckpointId(rootChain string, contractAddress string) (currentHeader uint64, err error)
+	GetSyncedCheckpointId(contractAddress string, rootChainID byte) (currentHeader uint64, err error)
+	GetMainSyncedCheckpointId(rootChainID byte, stakingManagerInstance *stakemanager.Stakemanager) (currentHeader uint64, err error)
+	SendCheckpointSyncToTron(signedData []byte, sigs [][3]*big.Int, stakeManagerAddress string) error
 	GetStartListenBlock(rootChainType string) uint64
 
 	// new chain
 	DecodeNewChainEvent(common.Address, *ethTypes.Receipt, uint64) (*rootchain.RootchainNewChain, error)
+	DecodeChainStatusChangedEvent(common.Address, *ethTypes.Receipt, uint64) (*rootchain.RootchainChainStatusChanged, error)
 }
 
 // ContractCaller contract caller
@@ -146,6 +154,9 @@ type rpcTransaction struct {
 
 const (
 	LRUCapacity = 5000
+
+	// MaticHeadersBatchLimit max number of headers fetched in one batch call
+	MaticHeadersBatchLimit = 100
 )
 
 // NewContractCaller contract caller
@@ -178,12 +189,9 @@ func (c *ContractCaller) GetRootChainInstance(rootchainAddress common.Address, r
 	cacheKey := rootchainAddress.String() + rootChain
 	contractInstance, ok := c.ContractInstanceCache[cacheKey]
 	if !ok {
-		var client *ethclient.Client
-		switch rootChain {
-		case hmTypes.RootChainTypeEth:
-			client = mainChainClient
-		case hmTypes.RootChainTypeBsc:
-			client = bscChainClient
+		client, err := GetRootChainClient(rootChain)
+		if err != nil {
+			return nil, err
 		}
 		ci, err := rootchain.NewRootchain(rootchainAddress, client)
 		c.ContractInstanceCache[cacheKey] = ci
@@ -197,12 +205,9 @@ func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Addres
 	cacheKey := stakingInfoAddress.String() + rootChain
 	contractInstance, ok := c.ContractInstanceCache[cacheKey]
 	if !ok {
-		var client *ethclient.Client
-		switch rootChain {
-		case hmTypes.RootChainTypeEth:
-			client = mainChainClient
-		case hmTypes.RootChainTypeBsc:
-			client = bscChainClient
+		client, err := GetRootChainClient(rootChain)
+		if err != nil {
+			return nil, err
 		}
 		ci, err := stakinginfo.NewStakinginfo(stakingInfoAddress, client)
 		c.ContractInstanceCache[cacheKey] = ci
@@ -228,12 +233,9 @@ func (c *ContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Ad
 	cacheKey := stakingManagerAddress.String() + rootChain
 	contractInstance, ok := c.ContractInstanceCache[cacheKey]
 	if !ok {
-		var client *ethclient.Client
-		switch rootChain {
-		case hmTypes.RootChainTypeEth:
-			client = mainChainClient
-		case hmTypes.RootChainTypeBsc:
-			client = bscChainClient
+		client, err := GetRootChainClient(rootChain)
+		if err != nil {
+			return nil, err
 		}
 		ci, err := stakemanager.NewStakemanager(stakingManagerAddress, client)
 		c.ContractInstanceCache[cacheKey] = ci
@@ -340,6 +342,52 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 	return common.FromHex(rootHash), nil
 }
 
+// GetMaticChainBlockHeaders get block headers in range [start, end] from bor chain
+func (c *ContractCaller) GetMaticChainBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*ethTypes.Header, error) {
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	noOfBlock := end - start + 1
+	if noOfBlock > checkpointLength {
+		return nil, errors.New("number of headers requested exceeds")
+	}
+
+	headers := make([]*ethTypes.Header, noOfBlock)
+	elements := make([]rpc.BatchElem, noOfBlock)
+	for i := range elements {
+		elements[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{fmt.Sprintf("0x%x", start+uint64(i)), false},
+			Result: &headers[i],
+		}
+	}
+
+	for i := 0; i < len(elements); i += MaticHeadersBatchLimit {
+		last := i + MaticHeadersBatchLimit
+		if last > len(elements) {
+			last = len(elements)
+		}
+
+		if err := c.MaticChainRPC.BatchCall(elements[i:last]); err != nil {
+			Logger.Error("Unable to fetch headers from matic chain", "start", start, "end", end, "error", err)
+			return nil, err
+		}
+	}
+
+	for i, element := range elements {
+		if element.Error != nil {
+			return nil, element.Error
+		}
+
+		if headers[i] == nil {
+			return nil, fmt.Errorf("block %d not found on matic chain", start+uint64(i))
+		}
+	}
+
+	return headers, nil
+}
+
 // GetLastChildBlock fetch current child block
 func (c *ContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
 	GetLastChildBlock, err := rootChainInstance.GetLastChildBlock(nil)
@@ -399,15 +447,12 @@ func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID, stakingInfoIn
 
 // GetMainChainBlock returns main chain block header
 func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int, rootChain string) (header *ethTypes.Header, err error) {
-	var latestBlock *ethTypes.Header
-	switch rootChain {
-	case hmTypes.RootChainTypeEth:
-		latestBlock, err = c.MainChainClient.HeaderByNumber(context.Background(), blockNum)
-	case hmTypes.RootChainTypeBsc:
-		latestBlock, err = c.BscChainClient.HeaderByNumber(context.Background(), blockNum)
-	default:
-		return nil, errors.New("wrong chain type")
+	client, err := GetRootChainClient(rootChain)
+	if err != nil {
+		return nil, err
 	}
+
+	latestBlock, err := client.HeaderByNumber(context.Background(), blockNum)
 	if err != nil {
 		Logger.Error("Unable to connect to main chain", "Error", err)
 		return
@@ -415,6 +460,21 @@ func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int, rootChain string)
 	return latestBlock, nil
 }
 
+// GetMainChainGasPrice returns suggested gas price of main chain
+func (c *ContractCaller) GetMainChainGasPrice(rootChain string) (gasPrice *big.Int, err error) {
+	client, err := GetRootChainClient(rootChain)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err = client.SuggestGasPrice(context.Background())
+	if err != nil {
+		Logger.Error("Unable to fetch gas price from main chain", "Error", err)
+		return
+	}
+	return gasPrice, nil
+}
+
 func (c *ContractCaller) GetEthFinalizedBlock() (header *ethTypes.Header, err error) {
 	finalizedHeader, err := c.MainChainClient.HeaderByNumber(context.Background(),
 		big.NewInt(int64(rpc.FinalizedBlockNumber)))
@@ -675,6 +735,50 @@ func (c *ContractCaller) DecodeSignerUpdateEvent(contractAddress common.Address,
 	return event, nil
 }
 
+// DecodeShareMintedEvent represents delegator shares minted event
+func (c *ContractCaller) DecodeShareMintedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareMinted", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareBurnedEvent represents delegator shares burned event
+func (c *ContractCaller) DecodeShareBurnedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareBurned", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // DecodeStateSyncedEvent decode state sync data
 func (c *ContractCaller) DecodeStateSyncedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*statesender.StatesenderStateSynced, error) {
 	event := new(statesender.StatesenderStateSynced)
@@ -815,13 +919,12 @@ func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
 
 // GetMainTxReceipt returns main tx receipt
 func (c *ContractCaller) GetMainTxReceipt(txHash common.Hash, rootChain string) (*ethTypes.Receipt, error) {
-	switch rootChain {
-	case hmTypes.RootChainTypeEth:
-		return c.getTxReceipt(c.MainChainClient, txHash)
-	case hmTypes.RootChainTypeBsc:
-		return c.getTxReceipt(c.BscChainClient, txHash)
+	client, err := GetRootChainClient(rootChain)
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.New("wrong chain type")
+
+	return c.getTxReceipt(client, txHash)
 }
 
 // GetMaticTxReceipt returns matic tx receipt
@@ -1026,6 +1129,11 @@ func (c *ContractCaller) GetTronEventsByContractAddress(address []string, from,
 	return filterChangeResult.Result, nil
 }
 
+// GetTronEnergyFee returns current energy price of tron chain in sun
+func (c *ContractCaller) GetTronEnergyFee() (int64, error) {
+	return c.TronChainRPC.GetEnergyFee(context.Background())
+}
+
 func (c *ContractCaller) GetTronLatestBlockNumber() (int64, error) {
 	var empty []string
 	queryFilter := tron.FilterOtherParams{
@@ -1099,10 +1207,9 @@ func (c *ContractCaller) GetTronHeaderInfo(headerID uint64, contractAddress stri
 		ret.CreatedAt.Uint64(), types.HeimdallAddress(ret.Proposer), nil
 }
 
-func (c *ContractCaller) GetSyncedCheckpointId(contractAddress string, rootChain string) (currentHeader uint64, err error) {
+func (c *ContractCaller) GetSyncedCheckpointId(contractAddress string, rootChainID byte) (currentHeader uint64, err error) {
 	// Pack the input
-	chainID := types.GetRootChainID(rootChain)
-	btsPack, err := c.StakeManagerABI.Pack("getCurrentSyncedCheckpoint", big.NewInt(int64(chainID)))
+	btsPack, err := c.StakeManagerABI.Pack("getCurrentSyncedCheckpoint", big.NewInt(int64(rootChainID)))
 	if err != nil {
 		return 0, err
 	}
@@ -1123,6 +1230,16 @@ func (c *ContractCaller) GetSyncedCheckpointId(contractAddress string, rootChain
 	return (*ret).Uint64(), nil
 }
 
+// GetMainSyncedCheckpointId returns last checkpoint of root chain synced to EVM stake manager
+func (c *ContractCaller) GetMainSyncedCheckpointId(rootChainID byte, stakingManagerInstance *stakemanager.Stakemanager) (currentHeader uint64, err error) {
+	currentSynced, err := stakingManagerInstance.GetCurrentSyncedCheckpoint(nil, big.NewInt(int64(rootChainID)))
+	if err != nil {
+		return 0, err
+	}
+
+	return currentSynced.Uint64(), nil
+}
+
 // DecodeNewChainEvent represents validator stake update event
 func (c *ContractCaller) DecodeNewChainEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*rootchain.RootchainNewChain, error) {
 	event := new(rootchain.RootchainNewChain)
@@ -1144,3 +1261,25 @@ func (c *ContractCaller) DecodeNewChainEvent(contractAddress common.Address, rec
 
 	return event, nil
 }
+
+// DecodeChainStatusChangedEvent represents root chain status change event
+func (c *ContractCaller) DecodeChainStatusChangedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*rootchain.RootchainChainStatusChanged, error) {
+	event := new(rootchain.RootchainChainStatusChanged)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.RootChainABI, event, "ChainStatusChanged", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
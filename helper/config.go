@@ -2,6 +2,7 @@ package helper
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"log"
 	"math/big"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/maticnetwork/heimdall/file"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/spf13/viper"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -89,6 +91,9 @@ const (
 	DefaultMainchainMaxGasPrice = 400000000000 // 400 Gwei
 	DefaultTronFeeLimit         = uint64(200000000)
 
+	DefaultCheckpointCostThreshold = 50 // percent of max gas price / tron fee limit
+	DefaultCheckpointCostMaxDelay  = 1 * time.Hour
+
 	DefaultEthBusyLimitTxs  = 1000
 	DefaultBscBusyLimitTxs  = 1000
 	DefaultTronBusyLimitTxs = 20000
@@ -138,6 +143,10 @@ type Configuration struct {
 
 	MainchainMaxGasPrice int64 `mapstructure:"main_chain_max_gas_price"` // max gas price to mainchain transaction. eg....submit checkpoint.
 
+	CheckpointCostPolicy    bool          `mapstructure:"checkpoint_cost_policy"`    // defer checkpoints without cross-chain txs while root chain cost is high
+	CheckpointCostThreshold uint64        `mapstructure:"checkpoint_cost_threshold"` // percent of max gas price / tron fee limit above which checkpoints are deferred
+	CheckpointCostMaxDelay  time.Duration `mapstructure:"checkpoint_cost_max_delay"` // max time since last checkpoint before deferred checkpoint is sent anyway
+
 	// config related to bridge
 	CheckpointerPollInterval time.Duration `mapstructure:"checkpoint_poll_interval"`  // Poll interval for checkpointer service to send new checkpoints or missing ACK
 	EthSyncerPollInterval    time.Duration `mapstructure:"eth_syncer_poll_interval"`  // Poll interval for syncher service to sync for changes on eth chain
@@ -161,6 +170,8 @@ type Configuration struct {
 	EthMaxQueryBlocks  int64 `mapstructure:"eth_max_query_blocks"`  // eth max number of blocks in one query logs
 	BscMaxQueryBlocks  int64 `mapstructure:"bsc_max_query_blocks"`  // bsc max number of blocks in one query logs
 	TronMaxQueryBlocks int64 `mapstructure:"tron_max_query_blocks"` // tron max number of blocks in one query logs
+
+	RootChainRPCUrls map[string]string `mapstructure:"root_chain_rpc_urls"` // RPC endpoints of evm root chains added through chainmanager
 }
 
 var conf Configuration
@@ -173,6 +184,9 @@ var mainRPCClient *rpc.Client
 var bscChainClient *ethclient.Client
 var bscRPCClient *rpc.Client
 
+// rootChainClients stores eth clients of evm root chains, eth, bsc and chains added through chainmanager
+var rootChainClients = make(map[string]*ethclient.Client)
+
 var tronRPCClient *tron.Client
 
 // MaticClient stores eth/rpc client for Matic Network
@@ -253,6 +267,17 @@ func InitDeliveryConfigWith(homeDir string, deliveryConfigFilePath string) {
 	}
 	bscChainClient = ethclient.NewClient(bscRPCClient)
 
+	rootChainClients[hmTypes.RootChainTypeEth] = mainChainClient
+	rootChainClients[hmTypes.RootChainTypeBsc] = bscChainClient
+
+	for rootChain, rpcURL := range conf.RootChainRPCUrls {
+		rootChainRPCClient, err := rpc.Dial(rpcURL)
+		if err != nil {
+			log.Fatalln("Unable to dial via ethClient", "URL=", rpcURL, "chain=", rootChain, "Error", err)
+		}
+		rootChainClients[rootChain] = ethclient.NewClient(rootChainRPCClient)
+	}
+
 	tronRPCClient = tron.NewClient(conf.TronRPCUrl)
 
 	maticClient = ethclient.NewClient(maticRPCClient)
@@ -290,6 +315,10 @@ func GetDefaultHeimdallConfig() Configuration {
 
 		MainchainMaxGasPrice: DefaultMainchainMaxGasPrice,
 
+		CheckpointCostPolicy:    false,
+		CheckpointCostThreshold: DefaultCheckpointCostThreshold,
+		CheckpointCostMaxDelay:  DefaultCheckpointCostMaxDelay,
+
 		CheckpointerPollInterval: DefaultCheckpointerPollInterval,
 		EthSyncerPollInterval:    DefaultSyncerPollInterval,
 		BscSyncerPollInterval:    DefaultBscSyncerPollInterval,
@@ -354,6 +383,16 @@ func GetBscClient() *ethclient.Client {
 	return bscChainClient
 }
 
+// GetRootChainClient returns eth client for evm root chain
+func GetRootChainClient(rootChain string) (*ethclient.Client, error) {
+	client, ok := rootChainClients[rootChain]
+	if !ok {
+		return nil, fmt.Errorf("no rpc client configured for root chain %v", rootChain)
+	}
+
+	return client, nil
+}
+
 // GetTronChainRPCClient returns main chain RPC client
 func GetTronChainRPCClient() *tron.Client {
 	return tronRPCClient
@@ -138,6 +138,29 @@ func (_m *IContractCaller) CurrentStateCounter(stateSenderInstance *statesender.
 	return r0
 }
 
+// DecodeChainStatusChangedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeChainStatusChangedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*rootchain.RootchainChainStatusChanged, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *rootchain.RootchainChainStatusChanged
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *rootchain.RootchainChainStatusChanged); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootchain.RootchainChainStatusChanged)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeNewChainEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeNewChainEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*rootchain.RootchainNewChain, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -184,6 +207,52 @@ func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 common.Address, _a1 *ty
 	return r0, r1
 }
 
+// DecodeShareBurnedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareBurnedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareBurned
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareBurned); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareBurned)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeShareMintedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareMintedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareMinted
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareMinted); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareMinted)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeSignerUpdateEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoSignerChange, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -575,6 +644,29 @@ func (_m *IContractCaller) GetMainChainBlock(_a0 *big.Int, _a1 string) (*types.H
 	return r0, r1
 }
 
+// GetMainChainGasPrice provides a mock function with given fields: rootChain
+func (_m *IContractCaller) GetMainChainGasPrice(rootChain string) (*big.Int, error) {
+	ret := _m.Called(rootChain)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(string) *big.Int); ok {
+		r0 = rf(rootChain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(rootChain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMainStakingSyncNonce provides a mock function with given fields: validatorID, stakingManagerInstance
 func (_m *IContractCaller) GetMainStakingSyncNonce(validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) uint64 {
 	ret := _m.Called(validatorID, stakingManagerInstance)
@@ -589,6 +681,27 @@ func (_m *IContractCaller) GetMainStakingSyncNonce(validatorID uint64, stakingMa
 	return r0
 }
 
+// GetMainSyncedCheckpointId provides a mock function with given fields: rootChainID, stakingManagerInstance
+func (_m *IContractCaller) GetMainSyncedCheckpointId(rootChainID byte, stakingManagerInstance *stakemanager.Stakemanager) (uint64, error) {
+	ret := _m.Called(rootChainID, stakingManagerInstance)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(byte, *stakemanager.Stakemanager) uint64); ok {
+		r0 = rf(rootChainID, stakingManagerInstance)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(byte, *stakemanager.Stakemanager) error); ok {
+		r1 = rf(rootChainID, stakingManagerInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMainTxReceipt provides a mock function with given fields: _a0, _a1
 func (_m *IContractCaller) GetMainTxReceipt(_a0 common.Hash, _a1 string) (*types.Receipt, error) {
 	ret := _m.Called(_a0, _a1)
@@ -635,6 +748,29 @@ func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, erro
 	return r0, r1
 }
 
+// GetMaticChainBlockHeaders provides a mock function with given fields: start, end, checkpointLength
+func (_m *IContractCaller) GetMaticChainBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*types.Header, error) {
+	ret := _m.Called(start, end, checkpointLength)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(uint64, uint64, uint64) []*types.Header); ok {
+		r0 = rf(start, end, checkpointLength)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64, uint64) error); ok {
+		r1 = rf(start, end, checkpointLength)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMaticTokenInstance provides a mock function with given fields: maticTokenAddress
 func (_m *IContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
 	ret := _m.Called(maticTokenAddress)
@@ -897,20 +1033,20 @@ func (_m *IContractCaller) GetStateSenderInstance(stateSenderAddress common.Addr
 	return r0, r1
 }
 
-// GetSyncedCheckpointId provides a mock function with given fields: rootChain, contractAddress
-func (_m *IContractCaller) GetSyncedCheckpointId(rootChain string, contractAddress string) (uint64, error) {
-	ret := _m.Called(rootChain, contractAddress)
+// GetSyncedCheckpointId provides a mock function with given fields: contractAddress, rootChainID
+func (_m *IContractCaller) GetSyncedCheckpointId(contractAddress string, rootChainID byte) (uint64, error) {
+	ret := _m.Called(contractAddress, rootChainID)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(string, string) uint64); ok {
-		r0 = rf(rootChain, contractAddress)
+	if rf, ok := ret.Get(0).(func(string, byte) uint64); ok {
+		r0 = rf(contractAddress, rootChainID)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(rootChain, contractAddress)
+	if rf, ok := ret.Get(1).(func(string, byte) error); ok {
+		r1 = rf(contractAddress, rootChainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -941,6 +1077,27 @@ func (_m *IContractCaller) GetTronEventsByContractAddress(address []string, from
 	return r0, r1
 }
 
+// GetTronEnergyFee provides a mock function with given fields:
+func (_m *IContractCaller) GetTronEnergyFee() (int64, error) {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTronHeaderInfo provides a mock function with given fields: headerID, rootChainAddress, childBlockInterval
 func (_m *IContractCaller) GetTronHeaderInfo(headerID uint64, rootChainAddress string, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
 	ret := _m.Called(headerID, rootChainAddress, childBlockInterval)
@@ -1110,6 +1267,20 @@ func (_m *IContractCaller) SendCheckpoint(sigedData []byte, sigs [][3]*big.Int,
 	return r0
 }
 
+// SendCheckpointSyncToTron provides a mock function with given fields: signedData, sigs, stakeManagerAddress
+func (_m *IContractCaller) SendCheckpointSyncToTron(signedData []byte, sigs [][3]*big.Int, stakeManagerAddress string) error {
+	ret := _m.Called(signedData, sigs, stakeManagerAddress)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, string) error); ok {
+		r0 = rf(signedData, sigs, stakeManagerAddress)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendMainStakingSync provides a mock function with given fields: stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain
 func (_m *IContractCaller) SendMainStakingSync(stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) error {
 	ret := _m.Called(stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain)
@@ -0,0 +1,351 @@
+package helper
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// RootChainContracts holds root chain contract addresses used by root chain adapters
+type RootChainContracts struct {
+	RootChainAddress      string `json:"root_chain_address"`
+	StakingManagerAddress string `json:"staking_manager_address"`
+	StakingInfoAddress    string `json:"staking_info_address"`
+	StateSenderAddress    string `json:"state_sender_address"`
+}
+
+// RootChainAdapter wraps root chain specific contract interaction
+type RootChainAdapter interface {
+	// RootChain returns root chain type served by adapter
+	RootChain() string
+	// ParseAddress converts contract address to the address used in event logs
+	ParseAddress(address string) common.Address
+
+	// GetHeaderInfo returns checkpoint stored in root chain contract
+	GetHeaderInfo(headerID uint64, contracts RootChainContracts, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
+	// GetConfirmedTxReceipt returns tx receipt once it has required confirmations
+	GetConfirmedTxReceipt(txHash types.HeimdallHash, requiredConfirmations uint64, finalized bool) (*ethTypes.Receipt, error)
+	// SendCheckpoint submits signed checkpoint to root chain contract
+	SendCheckpoint(signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error
+	// GetCheckpointCost returns current cost of checkpoint submission and configured max cost
+	GetCheckpointCost() (cost, maxCost *big.Int, err error)
+
+	// GetStakingSyncNonce returns validator nonce from stake manager
+	GetStakingSyncNonce(validatorID uint64, contracts RootChainContracts) uint64
+	// SendStakingSync submits signed staking sync to stake manager
+	SendStakingSync(syncMethod string, signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error
+
+	// GetSyncedCheckpointID returns last checkpoint of source root chain synced to stake manager
+	GetSyncedCheckpointID(sourceChainID byte, contracts RootChainContracts) (uint64, error)
+	// SendCheckpointSync submits signed checkpoint sync to stake manager
+	SendCheckpointSync(signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error
+
+	// DecodeNewHeaderBlockEvent decodes checkpoint event of root chain contract in receipt
+	DecodeNewHeaderBlockEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*rootchain.RootchainNewHeaderBlock, error)
+	// DecodeStateSyncedEvent decodes state sync event of state sender contract in receipt
+	DecodeStateSyncedEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*statesender.StatesenderStateSynced, error)
+	// DecodeValidatorJoinEvent decodes validator join event of staking info contract in receipt
+	DecodeValidatorJoinEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoStaked, error)
+	// DecodeSignerUpdateEvent decodes signer change event of staking info contract in receipt
+	DecodeSignerUpdateEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoSignerChange, error)
+	// DecodeValidatorExitEvent decodes unstake event of staking info contract in receipt
+	DecodeValidatorExitEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoUnstakeInit, error)
+	// DecodeShareMintedEvent decodes delegation event of staking info contract in receipt
+	DecodeShareMintedEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoShareMinted, error)
+	// DecodeShareBurnedEvent decodes undelegation event of staking info contract in receipt
+	DecodeShareBurnedEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoShareBurned, error)
+}
+
+// TronCheckpointEnergy approximate energy used by submitCheckpoint on tron root chain contract
+const TronCheckpointEnergy = 200000
+
+// RootChainAdapterFactory creates root chain adapter on top of contract caller
+type RootChainAdapterFactory func(caller IContractCaller, rootChain string) RootChainAdapter
+
+var (
+	rootChainAdaptersMu sync.RWMutex
+	rootChainAdapters   = make(map[string]RootChainAdapterFactory)
+)
+
+func init() {
+	RegisterRootChainAdapter(types.RootChainTypeEth, NewEVMRootChainAdapter)
+	RegisterRootChainAdapter(types.RootChainTypeBsc, NewEVMRootChainAdapter)
+	RegisterRootChainAdapter(types.RootChainTypeTron, NewTronRootChainAdapter)
+}
+
+// RegisterRootChainAdapter registers adapter factory for root chain
+func RegisterRootChainAdapter(rootChain string, factory RootChainAdapterFactory) {
+	rootChainAdaptersMu.Lock()
+	defer rootChainAdaptersMu.Unlock()
+
+	rootChainAdapters[rootChain] = factory
+}
+
+// GetRootChainAdapter returns adapter for root chain, evm root chains added through
+// chain manager are served by evm adapter once their rpc url is configured
+func GetRootChainAdapter(caller IContractCaller, rootChain string) (RootChainAdapter, error) {
+	rootChainAdaptersMu.RLock()
+	defer rootChainAdaptersMu.RUnlock()
+
+	factory, ok := rootChainAdapters[rootChain]
+	if !ok {
+		if _, err := GetRootChainClient(rootChain); err != nil {
+			return nil, fmt.Errorf("no adapter registered for root chain %v", rootChain)
+		}
+
+		factory = NewEVMRootChainAdapter
+	}
+
+	return factory(caller, rootChain), nil
+}
+
+//
+// Events
+//
+
+// rootChainEvents decodes events of root chain contracts, contracts emit same events on every root chain
+type rootChainEvents struct {
+	caller       IContractCaller
+	parseAddress func(address string) common.Address
+}
+
+// DecodeNewHeaderBlockEvent decodes checkpoint event of root chain contract
+func (e rootChainEvents) DecodeNewHeaderBlockEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*rootchain.RootchainNewHeaderBlock, error) {
+	return e.caller.DecodeNewHeaderBlockEvent(e.parseAddress(contracts.RootChainAddress), receipt, logIndex)
+}
+
+// DecodeStateSyncedEvent decodes state sync event of state sender contract
+func (e rootChainEvents) DecodeStateSyncedEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*statesender.StatesenderStateSynced, error) {
+	return e.caller.DecodeStateSyncedEvent(e.parseAddress(contracts.StateSenderAddress), receipt, logIndex)
+}
+
+// DecodeValidatorJoinEvent decodes validator join event of staking info contract
+func (e rootChainEvents) DecodeValidatorJoinEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoStaked, error) {
+	return e.caller.DecodeValidatorJoinEvent(e.parseAddress(contracts.StakingInfoAddress), receipt, logIndex)
+}
+
+// DecodeSignerUpdateEvent decodes signer change event of staking info contract
+func (e rootChainEvents) DecodeSignerUpdateEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoSignerChange, error) {
+	return e.caller.DecodeSignerUpdateEvent(e.parseAddress(contracts.StakingInfoAddress), receipt, logIndex)
+}
+
+// DecodeValidatorExitEvent decodes unstake event of staking info contract
+func (e rootChainEvents) DecodeValidatorExitEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoUnstakeInit, error) {
+	return e.caller.DecodeValidatorExitEvent(e.parseAddress(contracts.StakingInfoAddress), receipt, logIndex)
+}
+
+// DecodeShareMintedEvent decodes delegation event of staking info contract
+func (e rootChainEvents) DecodeShareMintedEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoShareMinted, error) {
+	return e.caller.DecodeShareMintedEvent(e.parseAddress(contracts.StakingInfoAddress), receipt, logIndex)
+}
+
+// DecodeShareBurnedEvent decodes undelegation event of staking info contract
+func (e rootChainEvents) DecodeShareBurnedEvent(receipt *ethTypes.Receipt, logIndex uint64, contracts RootChainContracts) (*stakinginfo.StakinginfoShareBurned, error) {
+	return e.caller.DecodeShareBurnedEvent(e.parseAddress(contracts.StakingInfoAddress), receipt, logIndex)
+}
+
+//
+// EVM root chains
+//
+
+// EVMRootChainAdapter adapter for EVM compatible root chains
+type EVMRootChainAdapter struct {
+	rootChainEvents
+
+	caller    IContractCaller
+	rootChain string
+}
+
+// NewEVMRootChainAdapter creates adapter for EVM compatible root chain
+func NewEVMRootChainAdapter(caller IContractCaller, rootChain string) RootChainAdapter {
+	return &EVMRootChainAdapter{
+		rootChainEvents: rootChainEvents{caller: caller, parseAddress: common.HexToAddress},
+		caller:          caller,
+		rootChain:       rootChain,
+	}
+}
+
+// RootChain returns root chain type
+func (a *EVMRootChainAdapter) RootChain() string {
+	return a.rootChain
+}
+
+// ParseAddress converts hex address
+func (a *EVMRootChainAdapter) ParseAddress(address string) common.Address {
+	return common.HexToAddress(address)
+}
+
+// GetHeaderInfo returns checkpoint from root chain contract
+func (a *EVMRootChainAdapter) GetHeaderInfo(headerID uint64, contracts RootChainContracts, childBlockInterval uint64) (
+	root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error,
+) {
+	rootChainInstance, err := a.caller.GetRootChainInstance(a.ParseAddress(contracts.RootChainAddress), a.rootChain)
+	if err != nil {
+		return root, start, end, createdAt, proposer, err
+	}
+
+	return a.caller.GetHeaderInfo(headerID, rootChainInstance, childBlockInterval)
+}
+
+// GetConfirmedTxReceipt returns confirmed tx receipt
+func (a *EVMRootChainAdapter) GetConfirmedTxReceipt(txHash types.HeimdallHash, requiredConfirmations uint64, finalized bool) (*ethTypes.Receipt, error) {
+	return a.caller.GetConfirmedTxReceipt(txHash.EthHash(), requiredConfirmations, a.rootChain, finalized)
+}
+
+// SendCheckpoint submits checkpoint to root chain contract
+func (a *EVMRootChainAdapter) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error {
+	rootChainAddress := a.ParseAddress(contracts.RootChainAddress)
+
+	rootChainInstance, err := a.caller.GetRootChainInstance(rootChainAddress, a.rootChain)
+	if err != nil {
+		return err
+	}
+
+	return a.caller.SendCheckpoint(signedData, sigs, rootChainAddress, rootChainInstance, a.rootChain)
+}
+
+// GetCheckpointCost returns suggested gas price and configured max gas price
+func (a *EVMRootChainAdapter) GetCheckpointCost() (cost, maxCost *big.Int, err error) {
+	cost, err = a.caller.GetMainChainGasPrice(a.rootChain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mainChainMaxGasPrice := GetConfig().MainchainMaxGasPrice
+	if mainChainMaxGasPrice <= 0 {
+		mainChainMaxGasPrice = DefaultMainchainMaxGasPrice
+	}
+
+	return cost, big.NewInt(mainChainMaxGasPrice), nil
+}
+
+// GetStakingSyncNonce returns validator nonce from stake manager
+func (a *EVMRootChainAdapter) GetStakingSyncNonce(validatorID uint64, contracts RootChainContracts) uint64 {
+	stakingManagerInstance, err := a.caller.GetStakeManagerInstance(a.ParseAddress(contracts.StakingManagerAddress), a.rootChain)
+	if err != nil {
+		Logger.Error("Error while creating stake manager instance", "root", a.rootChain, "error", err)
+		return 0
+	}
+
+	return a.caller.GetMainStakingSyncNonce(validatorID, stakingManagerInstance)
+}
+
+// SendStakingSync submits staking sync to stake manager
+func (a *EVMRootChainAdapter) SendStakingSync(syncMethod string, signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error {
+	stakingManagerAddress := a.ParseAddress(contracts.StakingManagerAddress)
+
+	stakingManagerInstance, err := a.caller.GetStakeManagerInstance(stakingManagerAddress, a.rootChain)
+	if err != nil {
+		return err
+	}
+
+	return a.caller.SendMainStakingSync(syncMethod, signedData, sigs, stakingManagerAddress, stakingManagerInstance, a.rootChain)
+}
+
+// GetSyncedCheckpointID returns last checkpoint of source root chain synced to stake manager
+func (a *EVMRootChainAdapter) GetSyncedCheckpointID(sourceChainID byte, contracts RootChainContracts) (uint64, error) {
+	stakingManagerInstance, err := a.caller.GetStakeManagerInstance(a.ParseAddress(contracts.StakingManagerAddress), a.rootChain)
+	if err != nil {
+		return 0, err
+	}
+
+	return a.caller.GetMainSyncedCheckpointId(sourceChainID, stakingManagerInstance)
+}
+
+// SendCheckpointSync submits checkpoint sync to stake manager
+func (a *EVMRootChainAdapter) SendCheckpointSync(signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error {
+	return a.SendStakingSync("submitCheckpointSync", signedData, sigs, contracts)
+}
+
+//
+// Tron
+//
+
+// TronRootChainAdapter adapter for tron
+type TronRootChainAdapter struct {
+	rootChainEvents
+
+	caller    IContractCaller
+	rootChain string
+}
+
+// NewTronRootChainAdapter creates adapter for tron
+func NewTronRootChainAdapter(caller IContractCaller, rootChain string) RootChainAdapter {
+	return &TronRootChainAdapter{
+		rootChainEvents: rootChainEvents{caller: caller, parseAddress: types.HexToTronAddress},
+		caller:          caller,
+		rootChain:       rootChain,
+	}
+}
+
+// RootChain returns root chain type
+func (a *TronRootChainAdapter) RootChain() string {
+	return a.rootChain
+}
+
+// ParseAddress converts tron hex address
+func (a *TronRootChainAdapter) ParseAddress(address string) common.Address {
+	return types.HexToTronAddress(address)
+}
+
+// GetHeaderInfo returns checkpoint from tron root chain contract
+func (a *TronRootChainAdapter) GetHeaderInfo(headerID uint64, contracts RootChainContracts, childBlockInterval uint64) (
+	root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error,
+) {
+	return a.caller.GetTronHeaderInfo(headerID, contracts.RootChainAddress, childBlockInterval)
+}
+
+// GetConfirmedTxReceipt returns tx receipt, tron receipts are only returned once solidified
+func (a *TronRootChainAdapter) GetConfirmedTxReceipt(txHash types.HeimdallHash, requiredConfirmations uint64, finalized bool) (*ethTypes.Receipt, error) {
+	return a.caller.GetTronTransactionReceipt(txHash.Hex())
+}
+
+// SendCheckpoint submits checkpoint to tron root chain contract
+func (a *TronRootChainAdapter) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error {
+	return a.caller.SendTronCheckpoint(signedData, sigs, contracts.RootChainAddress)
+}
+
+// GetCheckpointCost returns estimated checkpoint fee in sun and configured fee limit
+func (a *TronRootChainAdapter) GetCheckpointCost() (cost, maxCost *big.Int, err error) {
+	energyFee, err := a.caller.GetTronEnergyFee()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feeLimit := GetConfig().TronchainFeeLimit
+	if feeLimit == 0 {
+		feeLimit = DefaultTronFeeLimit
+	}
+
+	cost = new(big.Int).Mul(big.NewInt(energyFee), big.NewInt(TronCheckpointEnergy))
+
+	return cost, new(big.Int).SetUint64(feeLimit), nil
+}
+
+// GetStakingSyncNonce returns validator nonce from tron stake manager
+func (a *TronRootChainAdapter) GetStakingSyncNonce(validatorID uint64, contracts RootChainContracts) uint64 {
+	return a.caller.GetTronStakingSyncNonce(validatorID, contracts.StakingManagerAddress)
+}
+
+// SendStakingSync submits staking sync to tron stake manager
+func (a *TronRootChainAdapter) SendStakingSync(syncMethod string, signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error {
+	return a.caller.SendTronStakingSync(syncMethod, signedData, sigs, contracts.StakingManagerAddress)
+}
+
+// GetSyncedCheckpointID returns last checkpoint of source root chain synced to tron stake manager
+func (a *TronRootChainAdapter) GetSyncedCheckpointID(sourceChainID byte, contracts RootChainContracts) (uint64, error) {
+	return a.caller.GetSyncedCheckpointId(contracts.StakingManagerAddress, sourceChainID)
+}
+
+// SendCheckpointSync submits checkpoint sync to tron stake manager
+func (a *TronRootChainAdapter) SendCheckpointSync(signedData []byte, sigs [][3]*big.Int, contracts RootChainContracts) error {
+	return a.caller.SendCheckpointSyncToTron(signedData, sigs, contracts.StakingManagerAddress)
+}
@@ -0,0 +1,99 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+func TestGetRootChainAdapter(t *testing.T) {
+	caller := &ContractCaller{}
+
+	for _, rootChain := range []string{types.RootChainTypeEth, types.RootChainTypeBsc} {
+		adapter, err := GetRootChainAdapter(caller, rootChain)
+		require.NoError(t, err)
+		require.IsType(t, &EVMRootChainAdapter{}, adapter)
+		require.Equal(t, rootChain, adapter.RootChain())
+	}
+
+	adapter, err := GetRootChainAdapter(caller, types.RootChainTypeTron)
+	require.NoError(t, err)
+	require.IsType(t, &TronRootChainAdapter{}, adapter)
+	require.Equal(t, types.RootChainTypeTron, adapter.RootChain())
+
+	_, err = GetRootChainAdapter(caller, "unknown")
+	require.Error(t, err)
+
+	// evm chain added through chain manager with configured rpc client
+	rootChainClients["evm-added"] = nil
+	defer delete(rootChainClients, "evm-added")
+
+	adapter, err = GetRootChainAdapter(caller, "evm-added")
+	require.NoError(t, err)
+	require.IsType(t, &EVMRootChainAdapter{}, adapter)
+	require.Equal(t, "evm-added", adapter.RootChain())
+}
+
+func TestRegisterRootChainAdapter(t *testing.T) {
+	RegisterRootChainAdapter("evm-test", NewEVMRootChainAdapter)
+
+	adapter, err := GetRootChainAdapter(&ContractCaller{}, "evm-test")
+	require.NoError(t, err)
+	require.Equal(t, "evm-test", adapter.RootChain())
+
+	address := "0x6c468CF8c9879006E22EC4029696E005C2319C9D"
+	require.Equal(t, address, adapter.ParseAddress(address).Hex())
+}
+
+// checkpointCostCaller stubs the cost lookups used by adapters
+type checkpointCostCaller struct {
+	IContractCaller
+	gasPrice  *big.Int
+	energyFee int64
+}
+
+func (c *checkpointCostCaller) GetMainChainGasPrice(rootChain string) (*big.Int, error) {
+	return c.gasPrice, nil
+}
+
+func (c *checkpointCostCaller) GetTronEnergyFee() (int64, error) {
+	return c.energyFee, nil
+}
+
+func TestGetCheckpointCost(t *testing.T) {
+	SetTestConfig(Configuration{})
+
+	caller := &checkpointCostCaller{gasPrice: big.NewInt(100000000000), energyFee: 420}
+
+	adapter, err := GetRootChainAdapter(caller, types.RootChainTypeEth)
+	require.NoError(t, err)
+
+	cost, maxCost, err := adapter.GetCheckpointCost()
+	require.NoError(t, err)
+	require.Equal(t, caller.gasPrice, cost)
+	require.Equal(t, big.NewInt(DefaultMainchainMaxGasPrice), maxCost)
+
+	adapter, err = GetRootChainAdapter(caller, types.RootChainTypeTron)
+	require.NoError(t, err)
+
+	cost, maxCost, err = adapter.GetCheckpointCost()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(420*TronCheckpointEnergy), cost)
+	require.Equal(t, new(big.Int).SetUint64(DefaultTronFeeLimit), maxCost)
+}
+
+func TestGetRootChainClient(t *testing.T) {
+	_, err := GetRootChainClient(types.RootChainTypeEth)
+	require.NoError(t, err)
+
+	// chains without configured rpc url have no client
+	_, err = GetRootChainClient("evm-test")
+	require.Error(t, err)
+
+	caller := &ContractCaller{}
+	_, err = caller.GetMainChainGasPrice("evm-test")
+	require.Error(t, err)
+}
@@ -56,6 +56,11 @@ tron_chain_fee_limit = "{{ .TronchainFeeLimit }}"
 #### gas price ####
 main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
 
+#### checkpoint cost policy ####
+checkpoint_cost_policy = "{{ .CheckpointCostPolicy }}"
+checkpoint_cost_threshold = "{{ .CheckpointCostThreshold }}"
+checkpoint_cost_max_delay = "{{ .CheckpointCostMaxDelay }}"
+
 #### busy limits ####
 eth_unconfirmed_txs_busy_limit = "{{ .EthUnconfirmedTxsBusyLimit }}"
 bsc_unconfirmed_txs_busy_limit = "{{ .BscUnconfirmedTxsBusyLimit }}"
@@ -68,6 +73,11 @@ tron_max_query_blocks = "{{ .TronMaxQueryBlocks }}"
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+##### Root chains added through chainmanager #####
+# RPC endpoints of evm root chains other than eth and bsc, keyed by root chain type
+[root_chain_rpc_urls]
+{{ range $rootChain, $url := .RootChainRPCUrls }}{{ $rootChain }} = "{{ $url }}"
+{{ end }}
 `
 
 var configTemplate *template.Template
@@ -18,7 +18,6 @@ import (
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/contracts/slashmanager"
 	"github.com/maticnetwork/heimdall/contracts/stakemanager"
-	hmtypes "github.com/maticnetwork/heimdall/types"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -99,13 +98,12 @@ func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int,
 		return err
 	}
 
-	var client *ethclient.Client
-	switch rootChain {
-	case hmtypes.RootChainTypeEth:
-		client = GetMainClient()
-	case hmtypes.RootChainTypeBsc:
-		client = GetBscClient()
+	client, err := GetRootChainClient(rootChain)
+	if err != nil {
+		Logger.Error("Unable to get root chain client", "root", rootChain, "error", err)
+		return err
 	}
+
 	auth, err := GenerateAuthObj(client, rootChainAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
@@ -295,13 +293,12 @@ func (c *ContractCaller) SendMainStakingSync(syncMethod string, signedData []byt
 		Logger.Error("Unable to pack tx for submitStakingSync", "error", err, "syncMethod", syncMethod)
 		return err
 	}
-	var client *ethclient.Client
-	switch rootChain {
-	case hmtypes.RootChainTypeEth:
-		client = GetMainClient()
-	case hmtypes.RootChainTypeBsc:
-		client = GetBscClient()
+	client, err := GetRootChainClient(rootChain)
+	if err != nil {
+		Logger.Error("Unable to get root chain client", "root", rootChain, "error", err)
+		return err
 	}
+
 	auth, err := GenerateAuthObj(client, stakingManager, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
@@ -327,6 +324,8 @@ func (c *ContractCaller) SendMainStakingSync(syncMethod string, signedData []byt
 		tx, err = stakingManagerInstance.ValidatorExit(auth, signedData, sigs)
 	case "signerUpdate":
 		tx, err = stakingManagerInstance.SignerUpdate(auth, signedData, sigs)
+	case "submitCheckpointSync":
+		tx, err = stakingManagerInstance.SubmitCheckpointSync(auth, signedData, sigs)
 	default:
 		Logger.Error("Submitted new staking sync to stake chain failed", "method", syncMethod)
 		return
@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	featuremanagerTypes "github.com/maticnetwork/heimdall/featuremanager/types"
 	govtypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/params/types"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 )
 
 // NewParamChangeProposalHandler new param changes proposal handler
@@ -47,12 +49,44 @@ func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p types.ParameterC
 		}
 	}
 
+	return validateProducerUptime(ctx, k)
+}
+
+// validateProducerUptime rejects uptime weighted producer selection while slashing records no uptime history,
+// as every validator would count with full uptime
+func validateProducerUptime(ctx sdk.Context, k Keeper) sdk.Error {
+	borSubspace, ok := k.GetSubspace(borTypes.DefaultParamspace)
+	if !ok {
+		return nil
+	}
+
+	slashingSubspace, ok := k.GetSubspace(slashingTypes.DefaultParamspace)
+	if !ok {
+		return nil
+	}
+
+	var producerUptimeBlocks uint64
+	borSubspace.GetIfExists(ctx, borTypes.KeyProducerUptimeBlocks, &producerUptimeBlocks)
+
+	var uptimePeriodBlocks int64
+	slashingSubspace.GetIfExists(ctx, slashingTypes.KeyUptimePeriodBlocks, &uptimePeriodBlocks)
+
+	if producerUptimeBlocks > 0 && uptimePeriodBlocks <= 0 {
+		return types.ErrSettingParameter(k.codespace, string(borTypes.KeyProducerUptimeBlocks),
+			fmt.Sprintf("%d", producerUptimeBlocks), "slashing uptime history is disabled")
+	}
+
 	return nil
 }
 
 func handleFeatureChangeProposal(ctx sdk.Context, keeper Keeper,
 	p featuremanagerTypes.FeatureChangeProposal,
 ) sdk.Error {
+	// schemas may have changed since proposal was submitted, check again before update
+	if err := featuremanagerTypes.ValidateChanges(p.Changes); err != nil {
+		return err
+	}
+
 	for _, change := range p.Changes {
 		subspace, ok := keeper.GetSubspace(featuremanagerTypes.ModuleName)
 		if !ok {
@@ -11,10 +11,13 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/maticnetwork/heimdall/app"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/params"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/params/types"
 	paramTypes "github.com/maticnetwork/heimdall/params/types"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 )
 
 type testInput struct {
@@ -147,3 +150,21 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerProducerUptime(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+	hdlr := params.NewParamChangeProposalHandler(happ.ParamsKeeper)
+
+	// uptime weighting needs uptime history recorded by slashing
+	producerUptime := paramTypes.NewParamChange(borTypes.DefaultParamspace, string(borTypes.KeyProducerUptimeBlocks), `"2000"`)
+	require.Error(t, hdlr(ctx, testProposal(producerUptime)))
+
+	uptimePeriod := paramTypes.NewParamChange(slashingTypes.DefaultParamspace, string(slashingTypes.KeyUptimePeriodBlocks), `"1000"`)
+	require.NoError(t, hdlr(ctx, testProposal(producerUptime, uptimePeriod)))
+	require.Equal(t, uint64(2000), happ.BorKeeper.GetParams(ctx).ProducerUptimeBlocks)
+
+	// uptime history cannot be disabled while producer selection uses it
+	uptimePeriod = paramTypes.NewParamChange(slashingTypes.DefaultParamspace, string(slashingTypes.KeyUptimePeriodBlocks), `"0"`)
+	require.Error(t, hdlr(ctx, testProposal(uptimePeriod)))
+}
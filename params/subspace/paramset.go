@@ -13,3 +13,24 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// OptionalParamSet is implemented by ParamSets with params added after chain start.
+//
+// Chains started before such params existed don't have them stored, so they are
+// kept out of ParamSetPairs where a missing key makes GetParamSet panic.
+// RegisterParamSet, GetParamSet and SetParamSet handle optional pairs as well,
+// GetParamSet leaves values of optional keys missing in store untouched.
+type OptionalParamSet interface {
+	ParamSet
+	OptionalParamSetPairs() ParamSetPairs
+}
+
+// allParamSetPairs returns pairs of ps including optional ones
+func allParamSetPairs(ps ParamSet) ParamSetPairs {
+	pairs := ps.ParamSetPairs()
+	if ops, ok := ps.(OptionalParamSet); ok {
+		pairs = append(pairs, ops.OptionalParamSetPairs()...)
+	}
+
+	return pairs
+}
@@ -273,6 +273,12 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		}
 	}
 
+	if attr.vfn != nil {
+		if err := attr.vfn(ctx, dest); err != nil {
+			return err
+		}
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -285,11 +291,17 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
 		s.Get(ctx, pair.Key, pair.Value)
 	}
+
+	if ops, ok := ps.(OptionalParamSet); ok {
+		for _, pair := range ops.OptionalParamSetPairs() {
+			s.GetIfExists(ctx, pair.Key, pair.Value)
+		}
+	}
 }
 
 // Set from ParamSet
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
-	for _, pair := range ps.ParamSetPairs() {
+	for _, pair := range allParamSetPairs(ps) {
 		// pair.Field is a pointer to the field, so indirecting the ptr.
 		// go-amino automatically handles it but just for sure,
 		// since SetStruct is meant to be used in InitGenesis
@@ -2,12 +2,19 @@ package subspace
 
 import (
 	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type attribute struct {
-	ty reflect.Type
+	ty  reflect.Type
+	vfn ValueValidatorFn
 }
 
+// ValueValidatorFn validates parameter value before it is changed by Update,
+// value is a pointer to the new parameter value
+type ValueValidatorFn func(ctx sdk.Context, value interface{}) error
+
 // KeyTable subspaces appropriate type for each parameter key
 type KeyTable struct {
 	m map[string]attribute
@@ -68,9 +75,23 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	return t
 }
 
+// Register validator for registered key, run when parameter is changed by Update
+func (t KeyTable) RegisterValidator(key []byte, vfn ValueValidatorFn) KeyTable {
+	keystr := string(key)
+	attr, ok := t.m[keystr]
+	if !ok {
+		panic("parameter not registered")
+	}
+
+	attr.vfn = vfn
+	t.m[keystr] = attr
+
+	return t
+}
+
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
-	for _, kvp := range ps.ParamSetPairs() {
+	for _, kvp := range allParamSetPairs(ps) {
 		t = t.RegisterType(kvp.Key, kvp.Value)
 	}
 	return t
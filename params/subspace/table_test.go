@@ -1,8 +1,10 @@
 package subspace
 
 import (
+	"errors"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,6 +20,17 @@ func (tp *testparams) ParamSetPairs() ParamSetPairs {
 	}
 }
 
+type testoptionalparams struct {
+	testparams
+	o int64
+}
+
+func (tp *testoptionalparams) OptionalParamSetPairs() ParamSetPairs {
+	return ParamSetPairs{
+		{Key: []byte("o"), Value: &tp.o},
+	}
+}
+
 func TestKeyTable(t *testing.T) {
 	table := NewKeyTable()
 
@@ -33,3 +46,46 @@ func TestKeyTable(t *testing.T) {
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
 }
+
+func TestKeyTableValidator(t *testing.T) {
+	table := NewKeyTable().RegisterType([]byte("hello"), int64(0))
+
+	require.Panics(t, func() { table.RegisterValidator([]byte("world"), nil) })
+
+	table = table.RegisterValidator([]byte("hello"), func(_ sdk.Context, value interface{}) error {
+		if *value.(*int64) < 0 {
+			return errors.New("negative value")
+		}
+		return nil
+	})
+
+	ctx, space, _ := DefaultTestComponents(t)
+	space = space.WithKeyTable(table)
+
+	require.NoError(t, space.Update(ctx, []byte("hello"), []byte(`"5"`)))
+	require.Error(t, space.Update(ctx, []byte("hello"), []byte(`"-1"`)))
+
+	var value int64
+	space.Get(ctx, []byte("hello"), &value)
+	require.Equal(t, int64(5), value)
+}
+
+func TestOptionalParamSet(t *testing.T) {
+	ctx, space, _ := DefaultTestComponents(t)
+	space = space.WithKeyTable(NewKeyTable().RegisterParamSet(&testoptionalparams{}))
+
+	// chain started before optional param existed
+	space.SetParamSet(ctx, &testparams{i: 1, b: true})
+
+	params := testoptionalparams{o: 7}
+	require.NotPanics(t, func() { space.GetParamSet(ctx, &params) })
+	require.Equal(t, int64(1), params.i)
+	require.Equal(t, int64(7), params.o)
+
+	params.o = 3
+	space.SetParamSet(ctx, &params)
+
+	params = testoptionalparams{o: 7}
+	space.GetParamSet(ctx, &params)
+	require.Equal(t, int64(3), params.o)
+}
@@ -11,6 +11,11 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	// uptime history is kept even when slashing is not enabled
+	k.PruneValidatorUptimes(ctx)
+	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+		k.RecordValidatorUptime(ctx, voteInfo.Validator.Address, voteInfo.SignedLastBlock)
+	}
 
 	if !k.GetParams(ctx).EnableSlashing {
 		k.Logger(ctx).Debug("slashing is not enabled. To enable, send a proposal via governance")
@@ -10,4 +10,16 @@ const (
 	FlagSlashInfoBytes   = "slashinfo-bytes"
 	FlagTickID           = "tick-id"
 	FlagBlockNumber      = "block-number"
+	FlagStartHeight      = "start-height"
+	FlagEndHeight        = "end-height"
+
+	FlagSignedBlocksWindow    = "signed-blocks-window"
+	FlagMinSignedPerWindow    = "min-signed-per-window"
+	FlagSlashFractionDowntime = "slash-fraction-downtime"
+	FlagSlashFractionLimit    = "slash-fraction-limit"
+	FlagJailFractionLimit     = "jail-fraction-limit"
+
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
+	FlagLimit      = "limit"
 )
@@ -2,14 +2,17 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -28,6 +31,9 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			// GetCmdQuerySigningInfo(cdc),
 			GetCmdQueryParams(cdc),
+			GetCmdQueryDryRun(cdc),
+			GetCmdQueryUptimeHistory(cdc),
+			GetCmdQueryUptimeLeaderboard(cdc),
 		)...,
 	)
 	return slashingQueryCmd
@@ -99,3 +105,133 @@ $ <appcli> query slashing params
 		},
 	}
 }
+
+// GetCmdQueryDryRun implements the command to project downtime penalties under hypothetical params.
+func GetCmdQueryDryRun(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run",
+		Short: "Query validators current signing infos would slash or jail under hypothetical parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Replay signing infos and missed blocks against current parameters with given ones replaced:
+
+$ <appcli> query slashing dry-run --min-signed-per-window 0.9 --slash-fraction-downtime 0.05
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQuerySlashingDryRunParams(
+				viper.GetInt64(FlagSignedBlocksWindow),
+				viper.GetString(FlagMinSignedPerWindow),
+				viper.GetString(FlagSlashFractionDowntime),
+				viper.GetString(FlagSlashFractionLimit),
+				viper.GetString(FlagJailFractionLimit),
+			)
+
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySlashingDryRun)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagSignedBlocksWindow, 0, "--signed-blocks-window=<blocks>")
+	cmd.Flags().String(FlagMinSignedPerWindow, "", "--min-signed-per-window=<fraction>")
+	cmd.Flags().String(FlagSlashFractionDowntime, "", "--slash-fraction-downtime=<fraction>")
+	cmd.Flags().String(FlagSlashFractionLimit, "", "--slash-fraction-limit=<fraction>")
+	cmd.Flags().String(FlagJailFractionLimit, "", "--jail-fraction-limit=<fraction>")
+
+	return cmd
+}
+
+// GetCmdQueryUptimeHistory implements the command to query uptime history of validator.
+func GetCmdQueryUptimeHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uptime [validator-id]",
+		Short: "Query signed and missed blocks of validator per period",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Query uptime history of validator for periods starting between heights:
+
+$ <appcli> query slashing uptime 1 --from-height 1000 --to-height 5000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryUptimeHistoryParams(
+				hmTypes.NewValidatorID(validatorID),
+				viper.GetInt64(FlagFromHeight),
+				viper.GetInt64(FlagToHeight),
+			)
+
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptimeHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "--from-height=<height>")
+	cmd.Flags().Int64(FlagToHeight, 0, "--to-height=<height>")
+
+	return cmd
+}
+
+// GetCmdQueryUptimeLeaderboard implements the command to query validators ordered by uptime.
+func GetCmdQueryUptimeLeaderboard(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uptime-leaderboard",
+		Short: "Query validators ordered by uptime between heights",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryUptimeLeaderboardParams(
+				viper.GetInt64(FlagFromHeight),
+				viper.GetInt64(FlagToHeight),
+				viper.GetInt(FlagLimit),
+			)
+
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptimeLeaderboard)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "--from-height=<height>")
+	cmd.Flags().Int64(FlagToHeight, 0, "--to-height=<height>")
+	cmd.Flags().Int(FlagLimit, 0, "--limit=<validators>")
+
+	return cmd
+}
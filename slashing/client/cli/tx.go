@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -31,6 +32,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdUnjail(cdc),
 		GetCmdTick(cdc),
 		GetCmdTickAck(cdc),
+		GetCmdSubmitSideTxEvidence(cdc),
+		GetCmdSignalMaintenance(cdc),
 	)...)
 
 	return slashingTxCmd
@@ -178,3 +181,95 @@ func GetCmdTickAck(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdSubmitSideTxEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-tx-evidence [kind] [votes-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "submit side-tx votes of validator contradicting each other or bor chain",
+		Long: fmt.Sprintf(`submit signed side-tx votes of validator as evidence, kind is one of %s, %s, %s:
+
+$ <appcli> tx slashing side-tx-evidence conflicting-votes votes.json --id 1 --from mykey
+
+where votes.json contains votes with side sign data they were signed over,
+conflicting votes also carry signed precommits of validator with their results:
+
+[
+  {
+    "tx_hash": "0x...",
+    "result": 1,
+    "data": "0x...",
+    "sig": "0x...",
+    "precommit": {...}
+  }
+]
+`, types.SideTxEvidenceConflictingVotes, types.SideTxEvidenceConflictingCheckpoints, types.SideTxEvidenceInvalidCheckpoint),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator := viper.GetUint64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			contents, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var votes []types.SideTxVote
+			if err := cdc.UnmarshalJSON(contents, &votes); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitSideTxEvidence(
+				helper.GetFromAddress(cliCtx),
+				validator,
+				args[0],
+				votes,
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+
+	return cmd
+}
+
+func GetCmdSignalMaintenance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-maintenance",
+		Short: "announce maintenance window of validator, missed blocks in it don't count as downtime",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator := viper.GetUint64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			msg := types.NewMsgSignalMaintenance(
+				helper.GetFromAddress(cliCtx),
+				validator,
+				viper.GetInt64(FlagStartHeight),
+				viper.GetInt64(FlagEndHeight),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID>")
+	cmd.Flags().Int64(FlagStartHeight, 0, "--start-height=<start-height>")
+	cmd.Flags().Int64(FlagEndHeight, 0, "--end-height=<end-height>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+	cmd.MarkFlagRequired(FlagStartHeight)
+	cmd.MarkFlagRequired(FlagEndHeight)
+
+	return cmd
+}
@@ -61,6 +61,21 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/slashing/tick-count",
 		tickCountHandlerFn(cliCtx),
 	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/dry-run",
+		slashingDryRunHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/validators/{id}/uptime",
+		uptimeHistoryHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/uptime-leaderboard",
+		uptimeLeaderboardHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // http request handler to query signing info
@@ -378,3 +393,143 @@ func tickCountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, result)
 	}
 }
+
+// http request handler to query projected downtime penalties under hypothetical params
+func slashingDryRunHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var signedBlocksWindow int64
+		if v := r.FormValue("signed_blocks_window"); v != "" {
+			window, ok := rest.ParseInt64OrReturnBadRequest(w, v)
+			if !ok {
+				return
+			}
+			signedBlocksWindow = window
+		}
+
+		params := types.NewQuerySlashingDryRunParams(
+			signedBlocksWindow,
+			r.FormValue("min_signed_per_window"),
+			r.FormValue("slash_fraction_downtime"),
+			r.FormValue("slash_fraction_limit"),
+			r.FormValue("jail_fraction_limit"),
+		)
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySlashingDryRun)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// parseHeightRange parses optional from_height and to_height of request, zero to height means latest
+func parseHeightRange(w http.ResponseWriter, r *http.Request) (fromHeight int64, toHeight int64, ok bool) {
+	if v := r.FormValue("from_height"); v != "" {
+		if fromHeight, ok = rest.ParseInt64OrReturnBadRequest(w, v); !ok {
+			return
+		}
+	}
+
+	if v := r.FormValue("to_height"); v != "" {
+		if toHeight, ok = rest.ParseInt64OrReturnBadRequest(w, v); !ok {
+			return
+		}
+	}
+
+	return fromHeight, toHeight, true
+}
+
+// http request handler to query uptime history of validator
+func uptimeHistoryHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		fromHeight, toHeight, ok := parseHeightRange(w, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryUptimeHistoryParams(hmTypes.ValidatorID(id), fromHeight, toHeight)
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptimeHistory)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// http request handler to query validators ordered by uptime
+func uptimeLeaderboardHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		fromHeight, toHeight, ok := parseHeightRange(w, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryUptimeLeaderboardParams(fromHeight, toHeight, limit)
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUptimeLeaderboard)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
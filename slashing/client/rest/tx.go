@@ -29,6 +29,16 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/slashing/tick-ack",
 		newTickAckHandler(cliCtx),
 	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/side-tx-evidence",
+		newSideTxEvidenceHandler(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/maintenance",
+		newSignalMaintenanceHandler(cliCtx),
+	).Methods("POST")
 }
 
 // Unjail TX body
@@ -57,6 +67,20 @@ type TickAckReq struct {
 	BlockNumber uint64       `json:"block_number" yaml:"block_number"`
 }
 
+type SideTxEvidenceReq struct {
+	BaseReq rest.BaseReq       `json:"base_req"`
+	ID      uint64             `json:"ID"`
+	Kind    string             `json:"kind"`
+	Votes   []types.SideTxVote `json:"votes"`
+}
+
+type SignalMaintenanceReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	ID          uint64       `json:"ID"`
+	StartHeight int64        `json:"start_height"`
+	EndHeight   int64        `json:"end_height"`
+}
+
 func newUnjailRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// read req from Request
@@ -138,3 +162,57 @@ func newTickAckHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func newSideTxEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SideTxEvidenceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgSubmitSideTxEvidence(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.ID,
+			req.Kind,
+			req.Votes,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func newSignalMaintenanceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SignalMaintenanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgSignalMaintenance(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.ID,
+			req.StartHeight,
+			req.EndHeight,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,80 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// DryRunSlashing projects downtime penalties of current validators if signing infos and missed block
+// bit arrays were checked under params. Slashing runs on cached context and is never written.
+func (k *Keeper) DryRunSlashing(ctx sdk.Context, params types.Params) types.SlashingDryRun {
+	storedWindow := k.GetParams(ctx).SignedBlocksWindow
+	height := ctx.BlockHeight()
+
+	cacheCtx, _ := ctx.CacheContext()
+	k.SetParams(cacheCtx, params)
+
+	result := types.SlashingDryRun{
+		Params:          params,
+		Height:          height,
+		MaxMissedBlocks: params.SignedBlocksWindow - params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64(),
+		Validators:      []types.ValidatorSlashingDryRun{},
+	}
+
+	for _, validator := range k.sk.GetCurrentValidators(ctx) {
+		signInfo, found := k.GetValidatorSigningInfo(ctx, validator.ID)
+		if !found {
+			continue
+		}
+
+		// validators within first window or below threshold are not slashed
+		missed := k.countMissedBlocks(ctx, validator.ID, signInfo, storedWindow, params.SignedBlocksWindow)
+		if height <= signInfo.StartHeight+params.SignedBlocksWindow || missed <= result.MaxMissedBlocks {
+			continue
+		}
+
+		// already jailed validators are not slashed again
+		if valSlashInfo, found := k.GetBufferValSlashingInfo(cacheCtx, validator.ID); validator.Jailed || (found && valSlashInfo.IsJailed) {
+			continue
+		}
+
+		slashedAmount := k.SlashInterim(cacheCtx, validator.ID, params.SlashFractionDowntime)
+		valSlashInfo, _ := k.GetBufferValSlashingInfo(cacheCtx, validator.ID)
+
+		result.Validators = append(result.Validators, types.ValidatorSlashingDryRun{
+			ID:                 validator.ID,
+			Power:              validator.VotingPower,
+			MissedBlocks:       missed,
+			SlashedAmount:      slashedAmount,
+			TotalSlashedAmount: valSlashInfo.SlashedAmount,
+			Jailed:             valSlashInfo.IsJailed,
+		})
+	}
+
+	result.TotalSlashedAmount = k.GetTotalSlashedAmount(cacheCtx)
+	result.SlashLimitExceeded = k.IsSlashedLimitExceeded(cacheCtx)
+
+	return result
+}
+
+// countMissedBlocks counts missed blocks among latest window blocks in bit array, bit array holds at most storedWindow blocks
+func (k *Keeper) countMissedBlocks(ctx sdk.Context, valID hmTypes.ValidatorID, signInfo hmTypes.ValidatorSigningInfo, storedWindow int64, window int64) (missed int64) {
+	blocks := window
+	if blocks > storedWindow {
+		blocks = storedWindow
+	}
+
+	if blocks > signInfo.IndexOffset {
+		blocks = signInfo.IndexOffset
+	}
+
+	for i := int64(1); i <= blocks; i++ {
+		if k.GetValidatorMissedBlockBitArray(ctx, valID, (signInfo.IndexOffset-i)%storedWindow) {
+			missed++
+		}
+	}
+
+	return missed
+}
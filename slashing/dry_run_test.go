@@ -0,0 +1,109 @@
+package slashing_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type DryRunTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *DryRunTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 200})
+
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+	_, validators := chSim.LoadAccountValidators(2, t, suite.app.StakingKeeper, ctx)
+
+	// validator 1 missed 60 oldest blocks of window, validator 2 missed 20 latest
+	missed := [][2]int64{{0, 60}, {80, 100}}
+
+	for i, validator := range validators {
+		keeper.SetValidatorSigningInfo(ctx, validator.ID, hmTypes.NewValidatorSigningInfo(validator.ID, 0, 100, missed[i][1]-missed[i][0]))
+		for index := missed[i][0]; index < missed[i][1]; index++ {
+			keeper.SetValidatorMissedBlockBitArray(ctx, validator.ID, index, true)
+		}
+	}
+
+	require.NoError(t, suite.app.StakingKeeper.UpdateValidatorSetInStore(ctx, *hmTypes.NewValidatorSet(validators)))
+}
+
+func TestDryRunTestSuite(t *testing.T) {
+	suite.Run(t, new(DryRunTestSuite))
+}
+
+func (suite *DryRunTestSuite) dryRun(params slashingTypes.QuerySlashingDryRunParams) slashingTypes.SlashingDryRun {
+	keeper := suite.app.SlashingKeeper
+
+	dryRunParams, err := params.Apply(keeper.GetParams(suite.ctx))
+	require.NoError(suite.T(), err)
+
+	return keeper.DryRunSlashing(suite.ctx, dryRunParams)
+}
+
+func (suite *DryRunTestSuite) TestDryRunSlashing() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+
+	t.Run("CurrentParams", func(t *testing.T) {
+		result := suite.dryRun(slashingTypes.QuerySlashingDryRunParams{})
+		require.Equal(t, int64(50), result.MaxMissedBlocks)
+		require.Len(t, result.Validators, 1)
+
+		validator := result.Validators[0]
+		require.Equal(t, hmTypes.NewValidatorID(1), validator.ID)
+		require.Equal(t, int64(60), validator.MissedBlocks)
+		require.Equal(t, uint64(1), validator.SlashedAmount)
+		require.False(t, validator.Jailed)
+		require.False(t, result.SlashLimitExceeded)
+	})
+
+	t.Run("StricterParams", func(t *testing.T) {
+		result := suite.dryRun(slashingTypes.NewQuerySlashingDryRunParams(0, "0.9", "0.5", "", ""))
+		require.Equal(t, int64(10), result.MaxMissedBlocks)
+		require.Len(t, result.Validators, 2)
+
+		for _, validator := range result.Validators {
+			require.Equal(t, uint64(50), validator.SlashedAmount)
+			require.True(t, validator.Jailed)
+		}
+
+		require.Equal(t, uint64(100), result.TotalSlashedAmount)
+		require.True(t, result.SlashLimitExceeded)
+	})
+
+	t.Run("ShorterWindow", func(t *testing.T) {
+		// only 10 misses of validator 1 and 20 of validator 2 are in latest 50 blocks
+		result := suite.dryRun(slashingTypes.NewQuerySlashingDryRunParams(50, "", "", "", ""))
+		require.Equal(t, int64(25), result.MaxMissedBlocks)
+		require.Empty(t, result.Validators)
+	})
+
+	t.Run("InvalidParams", func(t *testing.T) {
+		_, err := slashingTypes.NewQuerySlashingDryRunParams(0, "1.5", "", "", "").Apply(keeper.GetParams(ctx))
+		require.Error(t, err)
+
+		_, err = slashingTypes.NewQuerySlashingDryRunParams(-1, "", "", "", "").Apply(keeper.GetParams(ctx))
+		require.Error(t, err)
+	})
+
+	t.Run("StateUnchanged", func(t *testing.T) {
+		require.Equal(t, uint64(0), keeper.GetTotalSlashedAmount(ctx))
+		require.Equal(t, slashingTypes.DefaultSignedBlocksWindow, keeper.GetParams(ctx).SignedBlocksWindow)
+
+		_, found := keeper.GetBufferValSlashingInfo(ctx, hmTypes.NewValidatorID(1))
+		require.False(t, found)
+	})
+}
@@ -37,6 +37,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetTickValSlashingInfo(ctx, tickValSlashInfo.ID, *tickValSlashInfo)
 	}
 
+	for valIDStr, history := range data.UptimeHistory {
+		valID, _ := strconv.ParseUint(valIDStr, 10, 64)
+		for _, uptime := range history {
+			keeper.SetValidatorUptime(ctx, hmTypes.ValidatorID(valID), uptime)
+		}
+	}
+
 	keeper.SetParams(ctx, data.Params)
 
 	// Set initial tick count
@@ -65,11 +72,21 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 
 	bufSlashInfos, _ := keeper.GetBufferValSlashingInfos(ctx)
 	tickSlashInfos, _ := keeper.GetTickValSlashingInfos(ctx)
-	return types.NewGenesisState(
+
+	uptimeHistory := make(map[string][]types.ValidatorUptime)
+	keeper.IterateValidatorUptimes(ctx, func(valID hmTypes.ValidatorID, uptime types.ValidatorUptime) (stop bool) {
+		uptimeHistory[valID.String()] = append(uptimeHistory[valID.String()], uptime)
+		return false
+	})
+
+	data = types.NewGenesisState(
 		params,
 		signingInfos,
 		missedBlocks,
 		bufSlashInfos,
 		tickSlashInfos,
 		keeper.GetTickCount(ctx))
+	data.UptimeHistory = uptimeHistory
+
+	return data
 }
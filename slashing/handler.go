@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +28,10 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return handleMsgTickAck(ctx, msg, k, contractCaller)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k, contractCaller)
+		case types.MsgSubmitSideTxEvidence:
+			return handleMsgSubmitSideTxEvidence(ctx, msg, k, contractCaller)
+		case types.MsgSignalMaintenance:
+			return handleMsgSignalMaintenance(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in slashing module").Result()
 		}
@@ -177,3 +182,118 @@ func handleMsgTickAck(ctx sdk.Context, msg types.MsgTickAck, k Keeper, contractC
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleMsgSubmitSideTxEvidence - validates evidence of contradicting side-tx votes
+// 1. slashing must be enabled and evidence not processed before
+// 2. votes are signed by validator and contradict each other
+func handleMsgSubmitSideTxEvidence(ctx sdk.Context, msg types.MsgSubmitSideTxEvidence, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating side-tx evidence msg",
+		"validatorId", msg.ID,
+		"kind", msg.Kind,
+	)
+
+	if !k.GetParams(ctx).EnableSlashing {
+		k.Logger(ctx).Error("Slashing is not enabled")
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Slashing is not enabled").Result()
+	}
+
+	// check if evidence is already processed
+	if k.HasSideTxEvidence(ctx, msg.EvidenceHash()) {
+		k.Logger(ctx).Error("Side-tx evidence already processed")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	if err := k.VerifySideTxEvidence(ctx, msg); err != nil {
+		k.Logger(ctx).Error("Invalid side-tx evidence", "validatorId", msg.ID, "error", err)
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSignalMaintenance - handles maintenance window announced by validator
+// 1. window must be enabled, signed by validator signer, not in past and within max length
+// 2. previous window must be over and cooldown since its start passed
+// 3. store window in signing info, misses in it are counted separately from downtime
+func handleMsgSignalMaintenance(ctx sdk.Context, msg types.MsgSignalMaintenance, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating signal maintenance msg",
+		"validatorId", msg.ID,
+		"startHeight", msg.StartHeight,
+		"endHeight", msg.EndHeight,
+	)
+
+	params := k.GetParams(ctx)
+	if params.MaxMaintenanceBlocks <= 0 {
+		k.Logger(ctx).Error("Maintenance windows are not enabled")
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Maintenance windows are not enabled").Result()
+	}
+
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Msg is not signed by validator signer",
+			"validatorId", msg.ID,
+			"signer", validator.Signer.String(),
+			"from", msg.From.String())
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, msg.ID)
+	if !found {
+		k.Logger(ctx).Error("Signing info not found", "validatorId", msg.ID)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Signing info not found for validator %v", msg.ID).Result()
+	}
+
+	height := ctx.BlockHeight()
+	if msg.StartHeight < height {
+		k.Logger(ctx).Error("Maintenance window starts in past", "startHeight", msg.StartHeight, "height", height)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Maintenance window starts before current height %v", height).Result()
+	}
+
+	if lastStart := height + params.MaxMaintenanceLeadBlocks; msg.StartHeight > lastStart {
+		k.Logger(ctx).Error("Maintenance window starts too far ahead", "startHeight", msg.StartHeight, "lastStartHeight", lastStart)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Maintenance window can't start after %v", lastStart).Result()
+	}
+
+	if msg.WindowLength() > params.MaxMaintenanceBlocks {
+		k.Logger(ctx).Error("Maintenance window too long", "length", msg.WindowLength(), "maxMaintenanceBlocks", params.MaxMaintenanceBlocks)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Maintenance window longer than %v blocks", params.MaxMaintenanceBlocks).Result()
+	}
+
+	if signInfo.MaintenanceWindows > 0 {
+		if signInfo.MaintenanceEndHeight >= height {
+			k.Logger(ctx).Error("Maintenance window already signalled", "startHeight", signInfo.MaintenanceStartHeight, "endHeight", signInfo.MaintenanceEndHeight)
+			return hmCommon.ErrInvalidMsg(k.Codespace(), "Maintenance window %v - %v already signalled", signInfo.MaintenanceStartHeight, signInfo.MaintenanceEndHeight).Result()
+		}
+
+		if nextStart := signInfo.MaintenanceStartHeight + params.MaintenanceCooldownBlocks; msg.StartHeight < nextStart {
+			k.Logger(ctx).Error("Maintenance window in cooldown", "startHeight", msg.StartHeight, "nextStartHeight", nextStart)
+			return hmCommon.ErrInvalidMsg(k.Codespace(), "Maintenance window can't start before %v", nextStart).Result()
+		}
+	}
+
+	signInfo.MaintenanceStartHeight = msg.StartHeight
+	signInfo.MaintenanceEndHeight = msg.EndHeight
+	signInfo.MaintenanceWindows++
+	k.SetValidatorSigningInfo(ctx, msg.ID, signInfo)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMaintenance,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatInt(msg.StartHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(msg.EndHeight, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
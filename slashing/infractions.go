@@ -1,10 +1,17 @@
 package slashing
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/slashing/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -42,6 +49,13 @@ func (k *Keeper) HandleValidatorSignature(ctx sdk.Context, addr []byte, power in
 	previous := k.GetValidatorMissedBlockBitArray(ctx, validator.ID, index)
 	k.Logger(ctx).Debug("validator signing status", "valID", validator.ID, "address", signerAddress, "previous", previous, "current", signed)
 	missed := !signed
+	// misses in signalled maintenance window are counted separately and don't count as downtime
+	if missed && signInfo.InMaintenance(height) {
+		signInfo.MaintenanceMissedBlocks++
+		missed = false
+		k.Logger(ctx).Debug("Validator missed block in maintenance window", "valID", validator.ID, "maintenanceMissedBlocks", signInfo.MaintenanceMissedBlocks)
+	}
+
 	switch {
 	case !previous && missed:
 		// Array value has changed from not missed to missed, increment counter
@@ -149,3 +163,117 @@ func (k *Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation)
 
 	return nil
 }
+
+// VerifySideTxEvidence checks that evidence votes are signed by the validator and contradict each other.
+// Checkpoint votes must be for bor chain of heimdall, root hash of invalid checkpoint is checked by side-tx.
+func (k *Keeper) VerifySideTxEvidence(ctx sdk.Context, msg types.MsgSubmitSideTxEvidence) sdk.Error {
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		return hmCommon.ErrNoValidator(k.Codespace())
+	}
+
+	// votes are signed by validator signer key
+	pubKey := validator.PubKey.CryptoPubKey()
+	for i, vote := range msg.Votes {
+		if !pubKey.VerifyBytes(vote.SignBytes(), vote.Sig) {
+			return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Vote %d is not signed by validator %v", i, msg.ID)
+		}
+	}
+
+	if msg.Kind == types.SideTxEvidenceConflictingVotes {
+		return k.verifyConflictingVotes(ctx, pubKey, msg.Votes)
+	}
+
+	// checkpoint evidences
+	borChainID := k.chainKeeper.GetParams(ctx).ChainParams.BorChainID
+
+	checkpoints := make([]checkpointTypes.MsgCheckpoint, 0, len(msg.Votes))
+	for i, vote := range msg.Votes {
+		if vote.Result != int32(abci.SideTxResultType_Yes) {
+			return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Vote %d is not yes vote", i)
+		}
+
+		checkpoint, err := checkpointTypes.DecodeCheckpointSideSignBytes(vote.Data)
+		if err != nil || checkpoint.BorChainID != borChainID || checkpoint.StartBlock > checkpoint.EndBlock {
+			return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Vote %d is not for checkpoint", i)
+		}
+
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	if msg.Kind == types.SideTxEvidenceConflictingCheckpoints {
+		if checkpoints[0].StartBlock != checkpoints[1].StartBlock ||
+			checkpoints[0].EndBlock != checkpoints[1].EndBlock ||
+			bytes.Equal(checkpoints[0].RootHash.Bytes(), checkpoints[1].RootHash.Bytes()) {
+			return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Checkpoints are not for same range with different root hash")
+		}
+	}
+
+	return nil
+}
+
+// verifyConflictingVotes checks that votes are carried by precommits of validator for same tx
+// at same height and round with different results. Evidence older than MaxEvidenceAge is rejected.
+func (k *Keeper) verifyConflictingVotes(ctx sdk.Context, pubKey crypto.PubKey, votes []types.SideTxVote) sdk.Error {
+	maxEvidenceAge := k.GetParams(ctx).MaxEvidenceAge
+
+	for i, vote := range votes {
+		precommit := vote.Precommit
+		if precommit.Type != tmTypes.PrecommitType || precommit.Verify(ctx.ChainID(), pubKey) != nil {
+			return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Vote %d precommit is not signed by validator", i)
+		}
+
+		if !hasSideTxResult(precommit, vote) {
+			return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Vote %d is not in its precommit", i)
+		}
+
+		if age := ctx.BlockTime().Sub(precommit.Timestamp); age > maxEvidenceAge {
+			return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Vote %d is too old, age of %v past max age of %v", i, age, maxEvidenceAge)
+		}
+	}
+
+	first, second := votes[0], votes[1]
+	if !bytes.Equal(first.TxHash.Bytes(), second.TxHash.Bytes()) ||
+		first.Precommit.Height != second.Precommit.Height ||
+		first.Precommit.Round != second.Precommit.Round ||
+		first.Result == second.Result {
+		return hmCommon.ErrInvalidSideTxEvidence(k.Codespace(), "Votes are not for same tx at same height with different results")
+	}
+
+	return nil
+}
+
+// hasSideTxResult reports whether precommit carries result and signature of vote for its tx
+func hasSideTxResult(precommit *tmTypes.Vote, vote types.SideTxVote) bool {
+	for _, result := range precommit.SideTxResults {
+		if bytes.Equal(result.TxHash, vote.TxHash.Bytes()) && result.Result == vote.Result && bytes.Equal(result.Sig, vote.Sig) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HandleSideTxEquivocation slashes validator for side-tx votes proven contradicting by evidence.
+// Evidence is recorded as processed, validator already jailed isn't slashed again.
+func (k *Keeper) HandleSideTxEquivocation(ctx sdk.Context, valID hmTypes.ValidatorID, evidenceHash []byte) (slashedAmount uint64, err error) {
+	validator, ok := k.sk.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		k.Logger(ctx).Error("Error fetching validator", "valID", valID)
+		return 0, errors.New("validator not found")
+	}
+
+	k.SetSideTxEvidence(ctx, evidenceHash)
+
+	valSlashInfo, found := k.GetBufferValSlashingInfo(ctx, validator.ID)
+	// if val is already in jailed state(in buffer or fixed), don't slash him anymore.
+	if validator.Jailed || (found && valSlashInfo.IsJailed) {
+		k.Logger(ctx).Info(fmt.Sprintf("Validator %s would have been slashed for side-tx evidence, but was already jailed", validator.ID))
+		return 0, nil
+	}
+
+	slashedAmount = k.SlashInterim(ctx, validator.ID, k.GetParams(ctx).SlashFractionSideTx)
+	k.Logger(ctx).Debug("Interim side-tx evidence slashing successful", "valID", validator.ID, "slashedAmount", slashedAmount)
+
+	return slashedAmount, nil
+}
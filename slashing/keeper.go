@@ -170,11 +170,16 @@ func (k *Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
 
 // SetParams sets the slashing module's parameters.
 func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if params.SlashFractionSideTx.IsNil() {
+		params.SlashFractionSideTx = types.DefaultSlashFractionSideTx
+	}
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
 // GetParams gets the slashing module's parameters.
 func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	// optional params missing in store keep defaults
+	params = types.DefaultParams()
 	k.paramSpace.GetParamSet(ctx, &params)
 	return
 }
@@ -607,3 +612,20 @@ func (k *Keeper) IterateSlashingSequencesAndApplyFn(ctx sdk.Context, f func(sequ
 	}
 	return
 }
+
+//
+// Side-tx evidence
+//
+
+// SetSideTxEvidence marks side-tx evidence as processed
+func (k *Keeper) SetSideTxEvidence(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetSideTxEvidenceKey(hash), types.DefaultValue)
+}
+
+// HasSideTxEvidence checks if side-tx evidence is already processed
+func (k *Keeper) HasSideTxEvidence(ctx sdk.Context, hash []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetSideTxEvidenceKey(hash))
+}
@@ -0,0 +1,136 @@
+package slashing_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/simulation"
+)
+
+type MaintenanceTestSuite struct {
+	suite.Suite
+
+	app      *app.HeimdallApp
+	ctx      sdk.Context
+	accounts []simulation.Account
+}
+
+func (suite *MaintenanceTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 10})
+	accounts, validators := chSim.LoadAccountValidators(2, suite.T(), suite.app.StakingKeeper, suite.ctx)
+	suite.accounts = accounts
+
+	for _, validator := range validators {
+		suite.app.SlashingKeeper.SetValidatorSigningInfo(suite.ctx, validator.ID, hmTypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0))
+	}
+
+	// maintenance windows are disabled by default
+	params := suite.app.SlashingKeeper.GetParams(suite.ctx)
+	params.MaxMaintenanceBlocks = 100
+	params.MaintenanceCooldownBlocks = 50
+	params.MaxMaintenanceLeadBlocks = 100
+	suite.app.SlashingKeeper.SetParams(suite.ctx, params)
+}
+
+func TestMaintenanceTestSuite(t *testing.T) {
+	suite.Run(t, new(MaintenanceTestSuite))
+}
+
+func (suite *MaintenanceTestSuite) TestHandleMsgSignalMaintenance() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+	handler := slashing.NewHandler(keeper, &mocks.IContractCaller{})
+	from := suite.accounts[0].Address
+	params := keeper.GetParams(ctx)
+
+	t.Run("InvalidWindow", func(t *testing.T) {
+		require.NotNil(t, slashingTypes.NewMsgSignalMaintenance(from, 1, 20, 19).ValidateBasic())
+		require.Nil(t, slashingTypes.NewMsgSignalMaintenance(from, 1, 20, 20).ValidateBasic())
+	})
+
+	t.Run("OtherSigner", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSignalMaintenance(from, 2, 20, 30)
+		require.Equal(t, common.CodeValSignerMismatch, handler(ctx, msg).Code)
+	})
+
+	t.Run("PastStart", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSignalMaintenance(from, 1, 9, 30)
+		require.False(t, handler(ctx, msg).IsOK())
+	})
+
+	t.Run("TooLong", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSignalMaintenance(from, 1, 20, 20+params.MaxMaintenanceBlocks)
+		require.False(t, handler(ctx, msg).IsOK())
+	})
+
+	t.Run("TooFarAhead", func(t *testing.T) {
+		start := ctx.BlockHeight() + params.MaxMaintenanceLeadBlocks + 1
+		msg := slashingTypes.NewMsgSignalMaintenance(from, 1, start, start+10)
+		require.False(t, handler(ctx, msg).IsOK())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSignalMaintenance(from, 1, 20, 30)
+		require.True(t, handler(ctx, msg).IsOK())
+
+		signInfo, found := keeper.GetValidatorSigningInfo(ctx, hmTypes.NewValidatorID(1))
+		require.True(t, found)
+		require.Equal(t, int64(20), signInfo.MaintenanceStartHeight)
+		require.Equal(t, int64(30), signInfo.MaintenanceEndHeight)
+		require.Equal(t, int64(1), signInfo.MaintenanceWindows)
+	})
+
+	t.Run("AlreadySignalled", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSignalMaintenance(from, 1, 40, 50)
+		require.False(t, handler(ctx, msg).IsOK())
+	})
+
+	t.Run("Cooldown", func(t *testing.T) {
+		afterWindow := ctx.WithBlockHeight(31)
+
+		msg := slashingTypes.NewMsgSignalMaintenance(from, 1, 40, 50)
+		require.False(t, handler(afterWindow, msg).IsOK())
+
+		msg = slashingTypes.NewMsgSignalMaintenance(from, 1, 20+params.MaintenanceCooldownBlocks, 30+params.MaintenanceCooldownBlocks)
+		require.True(t, handler(afterWindow, msg).IsOK())
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		params := keeper.GetParams(ctx)
+		params.MaxMaintenanceBlocks = slashingTypes.DefaultMaxMaintenanceBlocks
+		keeper.SetParams(ctx, params)
+
+		msg := slashingTypes.NewMsgSignalMaintenance(suite.accounts[1].Address, 2, 20, 30)
+		require.False(t, handler(ctx, msg).IsOK())
+	})
+}
+
+func (suite *MaintenanceTestSuite) TestHandleValidatorSignatureInMaintenance() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+	handler := slashing.NewHandler(keeper, &mocks.IContractCaller{})
+	valID, addr := hmTypes.NewValidatorID(1), suite.accounts[0].Address
+
+	require.True(t, handler(ctx, slashingTypes.NewMsgSignalMaintenance(addr, 1, 15, 24)).IsOK())
+
+	// misses 10 blocks before, during and after maintenance window
+	for height := int64(10); height < 30; height++ {
+		require.NoError(t, keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), addr.Bytes(), 100, false))
+	}
+
+	signInfo, found := keeper.GetValidatorSigningInfo(ctx, valID)
+	require.True(t, found)
+	require.Equal(t, int64(10), signInfo.MissedBlocksCounter)
+	require.Equal(t, int64(10), signInfo.MaintenanceMissedBlocks)
+	require.Equal(t, int64(20), signInfo.IndexOffset)
+}
@@ -46,6 +46,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySlashingSequence:
 			return querySlashingSequence(ctx, req, k)
 
+		case types.QuerySlashingDryRun:
+			return querySlashingDryRun(ctx, req, k)
+
+		case types.QueryUptimeHistory:
+			return queryUptimeHistory(ctx, req, k)
+
+		case types.QueryUptimeLeaderboard:
+			return queryUptimeLeaderboard(ctx, req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
 		}
@@ -248,3 +257,63 @@ func querySlashingSequence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 
 	return bz, nil
 }
+
+func querySlashingDryRun(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySlashingDryRunParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	dryRunParams, err := params.Apply(k.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid dry-run params: %s", err))
+	}
+
+	// json record
+	bz, err := json.Marshal(k.DryRunSlashing(ctx, dryRunParams))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryUptimeHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryUptimeHistoryParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	history := k.GetValidatorUptimeHistory(ctx, params.ValidatorID, params.FromHeight, params.ToHeight)
+	if history == nil {
+		history = []types.ValidatorUptime{}
+	}
+
+	// json record
+	bz, err := json.Marshal(types.NewValidatorUptimeSummary(params.ValidatorID, history))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryUptimeLeaderboard(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryUptimeLeaderboardParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	leaderboard := k.GetUptimeLeaderboard(ctx, params.FromHeight, params.ToHeight)
+	if params.Limit > 0 && len(leaderboard) > params.Limit {
+		leaderboard = leaderboard[:params.Limit]
+	}
+
+	// json record
+	bz, err := json.Marshal(leaderboard)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
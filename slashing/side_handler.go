@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -27,6 +29,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 			return SideHandleMsgTickAck(ctx, k, msg, contractCaller)
 		case types.MsgUnjail:
 			return SideHandleMsgUnjail(ctx, k, msg, contractCaller)
+		case types.MsgSubmitSideTxEvidence:
+			return SideHandleMsgSubmitSideTxEvidence(ctx, k, msg, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -46,6 +50,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 			return PostHandleMsgTickAck(ctx, k, msg, sideTxResult)
 		case types.MsgUnjail:
 			return PostHandleMsgUnjail(ctx, k, msg, sideTxResult)
+		case types.MsgSubmitSideTxEvidence:
+			return PostHandleMsgSubmitSideTxEvidence(ctx, k, msg, sideTxResult)
 		default:
 			errMsg := "Unrecognized slash Msg type: %s" + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -142,6 +148,42 @@ func SideHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, contrac
 	return
 }
 
+// SideHandleMsgSubmitSideTxEvidence handles MsgSubmitSideTxEvidence message for external call,
+// root hash voted in invalid checkpoint evidence must not match bor chain
+func SideHandleMsgSubmitSideTxEvidence(ctx sdk.Context, k Keeper, msg types.MsgSubmitSideTxEvidence, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for side-tx evidence msg",
+		"validatorId", msg.ID,
+		"kind", msg.Kind,
+	)
+
+	if msg.Kind == types.SideTxEvidenceInvalidCheckpoint {
+		checkpoint, err := checkpointTypes.DecodeCheckpointSideSignBytes(msg.Votes[0].Data)
+		if err != nil {
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidSideTxEvidence)
+		}
+
+		confirmations := k.chainKeeper.GetParams(ctx).MaticchainTxConfirmations
+
+		// checkpoint length is checked by checkpoint module, only root hash matters here
+		validCheckpoint, err := checkpointTypes.ValidateCheckpoint(checkpoint.StartBlock, checkpoint.EndBlock, checkpoint.RootHash,
+			checkpoint.EndBlock-checkpoint.StartBlock+1, contractCaller, confirmations)
+		if err != nil {
+			k.Logger(ctx).Error("Error validating checkpoint of side-tx evidence", "error", err,
+				"startBlock", checkpoint.StartBlock, "endBlock", checkpoint.EndBlock, "rootHash", checkpoint.RootHash)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidSideTxEvidence)
+		}
+
+		if validCheckpoint {
+			k.Logger(ctx).Error("Checkpoint of side-tx evidence is valid", "startBlock", checkpoint.StartBlock, "endBlock", checkpoint.EndBlock)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidSideTxEvidence)
+		}
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for side-tx evidence msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
 // PostHandleMsgTick  - handles slashing of validators
 // 1. copy slashBuffer into latestTickData
 // 2. flush slashBuffer, totalSlashedAmount
@@ -355,3 +397,56 @@ func PostHandleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail, sideTxR
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// PostHandleMsgSubmitSideTxEvidence - slashes validator for contradicting side-tx votes
+// 1. evidence is processed only once
+// 2. slash amount is added to buffer and pushed to contract by next tick
+// 3. emit event SideTxEvidence
+func PostHandleMsgSubmitSideTxEvidence(ctx sdk.Context, k Keeper, msg types.MsgSubmitSideTxEvidence, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if evidence is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping side-tx evidence since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// check for replay
+	evidenceHash := msg.EvidenceHash()
+	if k.HasSideTxEvidence(ctx, evidenceHash) {
+		k.Logger(ctx).Error("Side-tx evidence already processed")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting side-tx evidence slashing", "sideTxResult", sideTxResult)
+
+	slashedAmount, err := k.HandleSideTxEquivocation(ctx, msg.ID, evidenceHash)
+	if err != nil {
+		k.Logger(ctx).Error("Error handling side-tx evidence", "validatorId", msg.ID, "error", err)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// buffered slashing info
+	valSlashInfo, _ := k.GetBufferValSlashingInfo(ctx, msg.ID)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSideTxEvidence,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Kind),
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, hex.EncodeToString(evidenceHash)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(slashedAmount, 10)),
+			sdk.NewAttribute(types.AttributeKeyJailed, strconv.FormatBool(valSlashInfo.IsJailed)),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
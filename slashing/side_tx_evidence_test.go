@@ -0,0 +1,262 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/simulation"
+)
+
+type SideTxEvidenceTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	contractCaller mocks.IContractCaller
+	accounts       []simulation.Account
+}
+
+func (suite *SideTxEvidenceTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{})
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.accounts, _ = chSim.LoadAccountValidators(2, suite.T(), suite.app.StakingKeeper, suite.ctx)
+
+	params := suite.app.SlashingKeeper.GetParams(suite.ctx)
+	params.EnableSlashing = true
+	suite.app.SlashingKeeper.SetParams(suite.ctx, params)
+}
+
+func TestSideTxEvidenceTestSuite(t *testing.T) {
+	suite.Run(t, new(SideTxEvidenceTestSuite))
+}
+
+func (suite *SideTxEvidenceTestSuite) signVote(account simulation.Account, result abci.SideTxResultType, data []byte) slashingTypes.SideTxVote {
+	return suite.signVoteAt(account, hmTypes.HexToHeimdallHash("0x1"), 1, result, data)
+}
+
+// signVoteAt signs side-tx result of tx and precommit carrying it at height
+func (suite *SideTxEvidenceTestSuite) signVoteAt(account simulation.Account, txHash hmTypes.HeimdallHash, height int64, result abci.SideTxResultType, data []byte) slashingTypes.SideTxVote {
+	vote := slashingTypes.NewSideTxVote(txHash, result, data, nil)
+
+	sig, err := account.PrivKey.Sign(vote.SignBytes())
+	require.NoError(suite.T(), err)
+
+	vote.Sig = sig
+	vote.Precommit = &tmTypes.Vote{
+		Type:             tmTypes.PrecommitType,
+		Height:           height,
+		Timestamp:        suite.ctx.BlockTime(),
+		ValidatorAddress: account.PubKey.Address(),
+		SideTxResults: []tmTypes.SideTxResult{
+			{TxHash: txHash.Bytes(), Result: vote.Result, Sig: vote.Sig},
+		},
+	}
+
+	sig, err = account.PrivKey.Sign(vote.Precommit.SignBytes(suite.ctx.ChainID()))
+	require.NoError(suite.T(), err)
+
+	vote.Precommit.Signature = sig
+
+	return vote
+}
+
+func (suite *SideTxEvidenceTestSuite) checkpointData(start, end uint64, rootHash string) []byte {
+	borChainID := suite.app.ChainKeeper.GetParams(suite.ctx).ChainParams.BorChainID
+	msg := checkpointTypes.NewMsgCheckpointBlock(suite.accounts[0].Address, start, end, hmTypes.HexToHeimdallHash(rootHash),
+		hmTypes.HexToHeimdallHash("0x2"), borChainID, 1, hmTypes.RootChainTypeEth)
+
+	return msg.GetSideSignBytes()
+}
+
+func (suite *SideTxEvidenceTestSuite) TestHandleMsgSubmitSideTxEvidence() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+	handler := slashing.NewHandler(keeper, &suite.contractCaller)
+	account, from := suite.accounts[0], suite.accounts[1].Address
+
+	data := suite.checkpointData(1, 256, "0x3")
+	yes := suite.signVote(account, abci.SideTxResultType_Yes, data)
+	no := suite.signVote(account, abci.SideTxResultType_No, data)
+
+	t.Run("ConflictingVotes", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, no})
+		require.Nil(t, msg.ValidateBasic())
+		require.True(t, handler(ctx, msg).IsOK())
+	})
+
+	t.Run("SameResult", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, yes})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("OtherSigner", func(t *testing.T) {
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 2, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, no})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("TamperedVote", func(t *testing.T) {
+		tampered := no
+		tampered.Data = suite.checkpointData(1, 256, "0x4")
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, tampered})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("ShortSignature", func(t *testing.T) {
+		short := no
+		short.Sig = no.Sig[:64]
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, short})
+		require.Error(t, msg.ValidateBasic())
+
+		short = no
+		precommit := *no.Precommit
+		precommit.Signature = precommit.Signature[:64]
+		short.Precommit = &precommit
+
+		msg = slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, short})
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("NoPrecommit", func(t *testing.T) {
+		withoutPrecommit := no
+		withoutPrecommit.Precommit = nil
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, withoutPrecommit})
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("NotInPrecommit", func(t *testing.T) {
+		moved := no
+		moved.Precommit = yes.Precommit
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, moved})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("DifferentTx", func(t *testing.T) {
+		other := suite.signVoteAt(account, hmTypes.HexToHeimdallHash("0x2"), 1, abci.SideTxResultType_No, data)
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, other})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("DifferentHeight", func(t *testing.T) {
+		other := suite.signVoteAt(account, hmTypes.HexToHeimdallHash("0x1"), 2, abci.SideTxResultType_No, data)
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, other})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("TooOld", func(t *testing.T) {
+		maxEvidenceAge := keeper.GetParams(ctx).MaxEvidenceAge
+		oldCtx := ctx.WithBlockTime(ctx.BlockTime().Add(maxEvidenceAge + time.Second))
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, no})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(oldCtx, msg).Code)
+	})
+
+	t.Run("ConflictingCheckpoints", func(t *testing.T) {
+		other := suite.signVote(account, abci.SideTxResultType_Yes, suite.checkpointData(1, 256, "0x4"))
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingCheckpoints, []slashingTypes.SideTxVote{yes, other})
+		require.True(t, handler(ctx, msg).IsOK())
+
+		otherRange := suite.signVote(account, abci.SideTxResultType_Yes, suite.checkpointData(1, 255, "0x4"))
+		msg = slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingCheckpoints, []slashingTypes.SideTxVote{yes, otherRange})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("NotCheckpoint", func(t *testing.T) {
+		vote := suite.signVote(account, abci.SideTxResultType_Yes, []byte("not a checkpoint"))
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceInvalidCheckpoint, []slashingTypes.SideTxVote{vote})
+		require.Equal(t, common.CodeInvalidSideTxEvidence, handler(ctx, msg).Code)
+	})
+
+	t.Run("SlashingDisabled", func(t *testing.T) {
+		params := keeper.GetParams(ctx)
+		params.EnableSlashing = false
+		keeper.SetParams(ctx, params)
+
+		msg := slashingTypes.NewMsgSubmitSideTxEvidence(from, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, no})
+		require.False(t, handler(ctx, msg).IsOK())
+	})
+}
+
+func (suite *SideTxEvidenceTestSuite) TestSideHandleMsgSubmitSideTxEvidence() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+	sideHandler := slashing.NewSideTxHandler(keeper, &suite.contractCaller)
+
+	vote := suite.signVote(suite.accounts[0], abci.SideTxResultType_Yes, suite.checkpointData(1, 256, "0x3"))
+	msg := slashingTypes.NewMsgSubmitSideTxEvidence(suite.accounts[1].Address, 1, slashingTypes.SideTxEvidenceInvalidCheckpoint, []slashingTypes.SideTxVote{vote})
+
+	suite.contractCaller.On("CheckIfBlocksExist", mock.Anything).Return(true)
+
+	t.Run("RootHashMismatch", func(t *testing.T) {
+		suite.contractCaller.On("GetRootHash", uint64(1), uint64(256), uint64(256)).Return(hmTypes.HexToHeimdallHash("0x4").Bytes(), nil).Once()
+
+		result := sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result)
+	})
+
+	t.Run("RootHashMatch", func(t *testing.T) {
+		suite.contractCaller.On("GetRootHash", uint64(1), uint64(256), uint64(256)).Return(hmTypes.HexToHeimdallHash("0x3").Bytes(), nil).Once()
+
+		result := sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeInvalidSideTxEvidence), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+	})
+}
+
+func (suite *SideTxEvidenceTestSuite) TestPostHandleMsgSubmitSideTxEvidence() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+	handler := slashing.NewHandler(keeper, &suite.contractCaller)
+	postHandler := slashing.NewPostTxHandler(keeper, &suite.contractCaller)
+
+	data := suite.checkpointData(1, 256, "0x3")
+	yes := suite.signVote(suite.accounts[0], abci.SideTxResultType_Yes, data)
+	no := suite.signVote(suite.accounts[0], abci.SideTxResultType_No, data)
+	msg := slashingTypes.NewMsgSubmitSideTxEvidence(suite.accounts[1].Address, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{yes, no})
+
+	t.Run("NoResult", func(t *testing.T) {
+		result := postHandler(ctx, msg, abci.SideTxResultType_No)
+		require.False(t, result.IsOK())
+		require.False(t, keeper.HasSideTxEvidence(ctx, msg.EvidenceHash()))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		result := postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK())
+		require.True(t, keeper.HasSideTxEvidence(ctx, msg.EvidenceHash()))
+
+		// 5% of 100 power goes to tick buffer
+		slashingInfo, found := keeper.GetBufferValSlashingInfo(ctx, hmTypes.NewValidatorID(1))
+		require.True(t, found)
+		require.Equal(t, uint64(5), slashingInfo.SlashedAmount)
+		require.Equal(t, uint64(5), keeper.GetTotalSlashedAmount(ctx))
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		// same evidence with votes reordered
+		replay := slashingTypes.NewMsgSubmitSideTxEvidence(suite.accounts[0].Address, 1, slashingTypes.SideTxEvidenceConflictingVotes, []slashingTypes.SideTxVote{no, yes})
+
+		require.Equal(t, common.CodeOldTx, handler(ctx, replay).Code)
+		require.Equal(t, common.CodeOldTx, postHandler(ctx, replay, abci.SideTxResultType_Yes).Code)
+		require.Equal(t, uint64(5), keeper.GetTotalSlashedAmount(ctx))
+	})
+}
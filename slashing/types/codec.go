@@ -9,6 +9,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnjail{}, "slashing/MsgUnjail", nil)
 	cdc.RegisterConcrete(MsgTick{}, "slashing/MsgTick", nil)
 	cdc.RegisterConcrete(MsgTickAck{}, "slashing/MsgTickAck", nil)
+	cdc.RegisterConcrete(MsgSubmitSideTxEvidence{}, "slashing/MsgSubmitSideTxEvidence", nil)
+	cdc.RegisterConcrete(MsgSignalMaintenance{}, "slashing/MsgSignalMaintenance", nil)
 
 }
 
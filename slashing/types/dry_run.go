@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// QuerySlashingDryRunParams defines the params for the following queries:
+// - 'custom/slashing/dry-run'
+// Empty fields keep current value of param
+type QuerySlashingDryRunParams struct {
+	SignedBlocksWindow    int64  `json:"signed_blocks_window"`
+	MinSignedPerWindow    string `json:"min_signed_per_window"`
+	SlashFractionDowntime string `json:"slash_fraction_downtime"`
+	SlashFractionLimit    string `json:"slash_fraction_limit"`
+	JailFractionLimit     string `json:"jail_fraction_limit"`
+}
+
+// NewQuerySlashingDryRunParams creates a new QuerySlashingDryRunParams instance
+func NewQuerySlashingDryRunParams(signedBlocksWindow int64, minSignedPerWindow, slashFractionDowntime, slashFractionLimit, jailFractionLimit string) QuerySlashingDryRunParams {
+	return QuerySlashingDryRunParams{
+		SignedBlocksWindow:    signedBlocksWindow,
+		MinSignedPerWindow:    minSignedPerWindow,
+		SlashFractionDowntime: slashFractionDowntime,
+		SlashFractionLimit:    slashFractionLimit,
+		JailFractionLimit:     jailFractionLimit,
+	}
+}
+
+// Apply returns params with hypothetical values set
+func (p QuerySlashingDryRunParams) Apply(params Params) (Params, error) {
+	if p.SignedBlocksWindow < 0 {
+		return params, fmt.Errorf("signed blocks window must be positive: %d", p.SignedBlocksWindow)
+	}
+
+	if p.SignedBlocksWindow > 0 {
+		params.SignedBlocksWindow = p.SignedBlocksWindow
+	}
+
+	fractions := []struct {
+		name  string
+		value string
+		param *sdk.Dec
+	}{
+		{"min signed per window", p.MinSignedPerWindow, &params.MinSignedPerWindow},
+		{"downtime slash fraction", p.SlashFractionDowntime, &params.SlashFractionDowntime},
+		{"slash fraction limit", p.SlashFractionLimit, &params.SlashFractionLimit},
+		{"jail fraction limit", p.JailFractionLimit, &params.JailFractionLimit},
+	}
+
+	for _, fraction := range fractions {
+		if fraction.value == "" {
+			continue
+		}
+
+		v, err := sdk.NewDecFromStr(fraction.value)
+		if err != nil {
+			return params, fmt.Errorf("invalid %s: %s", fraction.name, err)
+		}
+
+		if v.IsNegative() || v.GT(sdk.OneDec()) {
+			return params, fmt.Errorf("%s should be between zero and one: %s", fraction.name, v)
+		}
+
+		*fraction.param = v
+	}
+
+	return params, nil
+}
+
+// ValidatorSlashingDryRun projected downtime penalty of validator
+type ValidatorSlashingDryRun struct {
+	ID                 hmTypes.ValidatorID `json:"ID"`
+	Power              int64               `json:"power"`
+	MissedBlocks       int64               `json:"missed_blocks"`
+	SlashedAmount      uint64              `json:"slashed_amount"`       // amount slashed for downtime
+	TotalSlashedAmount uint64              `json:"total_slashed_amount"` // slashed amount of validator in buffer including downtime
+	Jailed             bool                `json:"jailed"`
+}
+
+// SlashingDryRun projected downtime penalties of current signing infos under params
+type SlashingDryRun struct {
+	Params             Params                    `json:"params"`
+	Height             int64                     `json:"height"`
+	MaxMissedBlocks    int64                     `json:"max_missed_blocks"`
+	TotalSlashedAmount uint64                    `json:"total_slashed_amount"`
+	SlashLimitExceeded bool                      `json:"slash_limit_exceeded"`
+	Validators         []ValidatorSlashingDryRun `json:"validators"`
+}
@@ -3,12 +3,14 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash       = "slash"
-	EventTypeSlashLimit  = "slash-limit"
-	EventTypeTickConfirm = "tick-confirm"
-	EventTypeTickAck     = "tick-ack"
-	EventTypeUnjail      = "unjail"
-	EventTypeLiveness    = "liveness"
+	EventTypeSlash          = "slash"
+	EventTypeSlashLimit     = "slash-limit"
+	EventTypeTickConfirm    = "tick-confirm"
+	EventTypeTickAck        = "tick-ack"
+	EventTypeUnjail         = "unjail"
+	EventTypeLiveness       = "liveness"
+	EventTypeSideTxEvidence = "side-tx-evidence"
+	EventTypeMaintenance    = "maintenance"
 
 	AttributeKeyAddress        = "address"
 	AttributeKeyValID          = "valid"
@@ -20,6 +22,9 @@ const (
 	AttributeKeyReason         = "reason"
 	AttributeKeyJailed         = "jailed"
 	AttributeKeyMissedBlocks   = "missed_blocks"
+	AttributeKeyEvidenceHash   = "evidence-hash"
+	AttributeKeyStartHeight    = "start-height"
+	AttributeKeyEndHeight      = "end-height"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
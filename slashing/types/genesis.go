@@ -18,6 +18,7 @@ type GenesisState struct {
 	BufferValSlashingInfo []*hmTypes.ValidatorSlashingInfo        `json:"buffer_val_slash_info" yaml:"buffer_val_slash_info"`
 	TickValSlashingInfo   []*hmTypes.ValidatorSlashingInfo        `json:"tick_val_slash_info" yaml:"tick_val_slash_info"`
 	TickCount             uint64                                  `json:"tick_count" yaml:"tick_count"`
+	UptimeHistory         map[string][]ValidatorUptime            `json:"uptime_history,omitempty" yaml:"uptime_history"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -75,6 +76,31 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
 	}
 
+	sideTx := data.Params.SlashFractionSideTx
+	if !sideTx.IsNil() && (sideTx.IsNegative() || sideTx.GT(sdk.OneDec())) {
+		return fmt.Errorf("slashing fraction side-tx should be less than or equal to one and greater than zero, is %s", sideTx.String())
+	}
+
+	if data.Params.MaxMaintenanceBlocks < 0 {
+		return fmt.Errorf("max maintenance blocks cannot be negative, is %d", data.Params.MaxMaintenanceBlocks)
+	}
+
+	if data.Params.MaintenanceCooldownBlocks < 0 {
+		return fmt.Errorf("maintenance cooldown blocks cannot be negative, is %d", data.Params.MaintenanceCooldownBlocks)
+	}
+
+	if data.Params.MaxMaintenanceLeadBlocks < 0 {
+		return fmt.Errorf("max maintenance lead blocks cannot be negative, is %d", data.Params.MaxMaintenanceLeadBlocks)
+	}
+
+	if data.Params.UptimePeriodBlocks < 0 {
+		return fmt.Errorf("uptime period blocks cannot be negative, is %d", data.Params.UptimePeriodBlocks)
+	}
+
+	if data.Params.UptimeHistoryPeriods < 0 {
+		return fmt.Errorf("uptime history periods cannot be negative, is %d", data.Params.UptimeHistoryPeriods)
+	}
+
 	minSign := data.Params.MinSignedPerWindow
 	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
 		return fmt.Errorf("min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
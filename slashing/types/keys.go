@@ -37,6 +37,8 @@ var (
 	TickValSlashingInfoKey          = []byte{0x06} // Prefix for Slashing Info stored after tick tx
 	SlashingSequenceKey             = []byte{0x07} // prefix for each key for slashing sequence map
 	TickCountKey                    = []byte{0x08} // key to store Tick counts
+	SideTxEvidenceKey               = []byte{0x09} // prefix for each key for processed side-tx evidence
+	ValidatorUptimeKey              = []byte{0x0A} // prefix for uptime history of validator per period
 )
 
 // GetValidatorSigningInfoKey - stored by *valID*
@@ -69,3 +71,22 @@ func GetTickValSlashingInfoKey(id []byte) []byte {
 func GetSlashingSequenceKey(sequence string) []byte {
 	return append(SlashingSequenceKey, []byte(sequence)...)
 }
+
+// GetSideTxEvidenceKey returns processed side-tx evidence key
+func GetSideTxEvidenceKey(hash []byte) []byte {
+	return append(SideTxEvidenceKey, hash...)
+}
+
+// GetValidatorUptimePrefixKey returns uptime history prefix of validator, id is fixed size so ids don't prefix each other
+func GetValidatorUptimePrefixKey(valID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, valID)
+	return append(append([]byte{}, ValidatorUptimeKey...), b...)
+}
+
+// GetValidatorUptimeKey returns uptime key of validator for period starting at height, ordered by height
+func GetValidatorUptimeKey(valID uint64, startHeight int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(startHeight))
+	return append(GetValidatorUptimePrefixKey(valID), b...)
+}
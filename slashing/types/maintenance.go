@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Msg signal maintenance
+//
+
+var _ sdk.Msg = &MsgSignalMaintenance{}
+
+// MsgSignalMaintenance - struct for announcing planned maintenance window of validator
+type MsgSignalMaintenance struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	StartHeight int64                   `json:"start_height"`
+	EndHeight   int64                   `json:"end_height"`
+}
+
+// NewMsgSignalMaintenance creates signal maintenance msg
+func NewMsgSignalMaintenance(from hmTypes.HeimdallAddress, id uint64, startHeight int64, endHeight int64) MsgSignalMaintenance {
+	return MsgSignalMaintenance{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// Type returns message type
+func (msg MsgSignalMaintenance) Type() string {
+	return "signal-maintenance"
+}
+
+func (msg MsgSignalMaintenance) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSignalMaintenance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgSignalMaintenance) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSignalMaintenance) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.StartHeight <= 0 || msg.EndHeight < msg.StartHeight {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid maintenance window %v - %v", msg.StartHeight, msg.EndHeight)
+	}
+
+	return nil
+}
+
+// WindowLength returns number of blocks in maintenance window
+func (msg MsgSignalMaintenance) WindowLength() int64 {
+	return msg.EndHeight - msg.StartHeight + 1
+}
@@ -13,12 +13,20 @@ const (
 	DefaultParamspace           = ModuleName
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultMaxMaintenanceBlocks      = int64(0)
+	DefaultMaintenanceCooldownBlocks = int64(50000)
+	DefaultMaxMaintenanceLeadBlocks  = int64(10000)
+
+	DefaultUptimePeriodBlocks   = int64(0)
+	DefaultUptimeHistoryPeriods = int64(100)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultSlashFractionSideTx     = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionLimit      = sdk.NewDec(1).Quo(sdk.NewDec(3))
 	DefaultJailFractionLimit       = sdk.NewDec(1).Quo(sdk.NewDec(3))
 	DefaultMaxEvidenceAge          = 60 * 2 * time.Second
@@ -36,9 +44,16 @@ var (
 	KeyJailFractionLimit       = []byte("JailFractionLimit")
 	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
 	KeyEnableSlashing          = []byte("EnableSlashing")
+
+	KeySlashFractionSideTx       = []byte("SlashFractionSideTx")
+	KeyMaxMaintenanceBlocks      = []byte("MaxMaintenanceBlocks")
+	KeyMaintenanceCooldownBlocks = []byte("MaintenanceCooldownBlocks")
+	KeyMaxMaintenanceLeadBlocks  = []byte("MaxMaintenanceLeadBlocks")
+	KeyUptimePeriodBlocks        = []byte("UptimePeriodBlocks")
+	KeyUptimeHistoryPeriods      = []byte("UptimeHistoryPeriods")
 )
 
-var _ subspace.ParamSet = &Params{}
+var _ subspace.OptionalParamSet = &Params{}
 
 // Params - used for initializing default parameter for slashing at genesis
 type Params struct {
@@ -51,6 +66,14 @@ type Params struct {
 	JailFractionLimit       sdk.Dec       `json:"jail_fraction_limit" yaml:"jail_fraction_limit"`               // if slashedAmount crossed JailFraction of validatorPower, Jail him
 	MaxEvidenceAge          time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`
 	EnableSlashing          bool          `json:"enable_slashing" yaml:"enable_slashing"`
+	SlashFractionSideTx     sdk.Dec       `json:"slash_fraction_side_tx" yaml:"slash_fraction_side_tx"` // fraction amount to slash on contradicting side-tx votes
+
+	MaxMaintenanceBlocks      int64 `json:"max_maintenance_blocks" yaml:"max_maintenance_blocks"`           // max length of maintenance window, zero disables maintenance windows
+	MaintenanceCooldownBlocks int64 `json:"maintenance_cooldown_blocks" yaml:"maintenance_cooldown_blocks"` // min blocks between starts of maintenance windows of validator
+	MaxMaintenanceLeadBlocks  int64 `json:"max_maintenance_lead_blocks" yaml:"max_maintenance_lead_blocks"` // max blocks between signal and start of maintenance window
+
+	UptimePeriodBlocks   int64 `json:"uptime_period_blocks" yaml:"uptime_period_blocks"`     // blocks per uptime history period, zero disables uptime history
+	UptimeHistoryPeriods int64 `json:"uptime_history_periods" yaml:"uptime_history_periods"` // past uptime periods kept besides current one, older periods are pruned
 }
 
 // NewParams creates a new Params object
@@ -74,7 +97,8 @@ func NewParams(
 
 // ParamKeyTable for slashing module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().
+		RegisterParamSet(&Params{})
 }
 
 // String implements the stringer interface for Params
@@ -88,10 +112,17 @@ func (p Params) String() string {
   SlashFractionDowntime:   %s
   SlashFractionLimit:   %s
   JailFractionDowntime:   %s
-  EnableSlashing:   %s`,
+  EnableSlashing:   %s
+  SlashFractionSideTx:   %s
+  MaxMaintenanceBlocks:   %d
+  MaintenanceCooldownBlocks:   %d
+  MaxMaintenanceLeadBlocks:   %d
+  UptimePeriodBlocks:   %d
+  UptimeHistoryPeriods:   %d`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign, p.MaxEvidenceAge,
-		p.SlashFractionDowntime, p.SlashFractionLimit, p.JailFractionLimit, p.EnableSlashing)
+		p.SlashFractionDowntime, p.SlashFractionLimit, p.JailFractionLimit, p.EnableSlashing, p.SlashFractionSideTx,
+		p.MaxMaintenanceBlocks, p.MaintenanceCooldownBlocks, p.MaxMaintenanceLeadBlocks, p.UptimePeriodBlocks, p.UptimeHistoryPeriods)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -109,12 +140,32 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	}
 }
 
+// OptionalParamSetPairs - Implements params.OptionalParamSet, returns params added after chain start
+func (p *Params) OptionalParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeySlashFractionSideTx, Value: &p.SlashFractionSideTx},
+		{Key: KeyMaxMaintenanceBlocks, Value: &p.MaxMaintenanceBlocks},
+		{Key: KeyMaintenanceCooldownBlocks, Value: &p.MaintenanceCooldownBlocks},
+		{Key: KeyMaxMaintenanceLeadBlocks, Value: &p.MaxMaintenanceLeadBlocks},
+		{Key: KeyUptimePeriodBlocks, Value: &p.UptimePeriodBlocks},
+		{Key: KeyUptimeHistoryPeriods, Value: &p.UptimeHistoryPeriods},
+	}
+}
+
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultSlashFractionLimit, DefaultJailFractionLimit, DefaultMaxEvidenceAge, DefaultEnableSlashing,
 	)
+	params.SlashFractionSideTx = DefaultSlashFractionSideTx
+	params.MaxMaintenanceBlocks = DefaultMaxMaintenanceBlocks
+	params.MaintenanceCooldownBlocks = DefaultMaintenanceCooldownBlocks
+	params.MaxMaintenanceLeadBlocks = DefaultMaxMaintenanceLeadBlocks
+	params.UptimePeriodBlocks = DefaultUptimePeriodBlocks
+	params.UptimeHistoryPeriods = DefaultUptimeHistoryPeriods
+
+	return params
 }
 
 func validateSignedBlocksWindow(i interface{}) error {
@@ -17,6 +17,9 @@ const (
 	QueryTickSlashingInfos = "tickSlashingInfos"
 	QuerySlashingSequence  = "slashing-sequence"
 	QueryTickCount         = "tick-count"
+	QuerySlashingDryRun    = "dry-run"
+	QueryUptimeHistory     = "uptime-history"
+	QueryUptimeLeaderboard = "uptime-leaderboard"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
@@ -0,0 +1,194 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Side-tx evidence kinds
+const (
+	// same tx voted with different results in precommits of same height and round
+	SideTxEvidenceConflictingVotes = "conflicting-votes"
+	// yes votes on two checkpoints with different root hash for same range
+	SideTxEvidenceConflictingCheckpoints = "conflicting-checkpoints"
+	// yes vote on checkpoint which root hash doesn't match bor chain
+	SideTxEvidenceInvalidCheckpoint = "invalid-checkpoint"
+)
+
+// SideTxSigLength length of secp256k1 signatures of side-tx results and precommits
+const SideTxSigLength = 65
+
+// GetSideTxEvidenceVoteCount returns number of votes evidence kind needs, zero for unknown kind
+func GetSideTxEvidenceVoteCount(kind string) int {
+	switch kind {
+	case SideTxEvidenceConflictingVotes, SideTxEvidenceConflictingCheckpoints:
+		return 2
+	case SideTxEvidenceInvalidCheckpoint:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SideTxVote side-tx result signed by validator in its vote, with side sign data it was signed over
+type SideTxVote struct {
+	TxHash hmTypes.HeimdallHash `json:"tx_hash"` // covered by precommit signature only
+	Result int32                `json:"result"`
+	Data   hmTypes.HexBytes     `json:"data"`
+	Sig    hmTypes.HexBytes     `json:"sig"`
+
+	// signed precommit carrying the result, required for conflicting votes
+	Precommit *tmTypes.Vote `json:"precommit,omitempty"`
+}
+
+// NewSideTxVote creates side-tx vote
+func NewSideTxVote(txHash hmTypes.HeimdallHash, result abci.SideTxResultType, data []byte, sig []byte) SideTxVote {
+	return SideTxVote{
+		TxHash: txHash,
+		Result: int32(result),
+		Data:   data,
+		Sig:    sig,
+	}
+}
+
+// SignBytes returns bytes validator signed for side-tx result
+func (v SideTxVote) SignBytes() []byte {
+	result := tmTypes.SideTxResultWithData{
+		SideTxResult: tmTypes.SideTxResult{
+			TxHash: v.TxHash.Bytes(),
+			Result: v.Result,
+		},
+		Data: v.Data,
+	}
+
+	return result.GetBytes()
+}
+
+//
+// Msg submit side-tx evidence
+//
+
+var _ sdk.Msg = &MsgSubmitSideTxEvidence{}
+
+// MsgSubmitSideTxEvidence - struct for submitting side-tx votes of validator contradicting each other or bor chain
+type MsgSubmitSideTxEvidence struct {
+	From  hmTypes.HeimdallAddress `json:"from"`
+	ID    hmTypes.ValidatorID     `json:"id"`
+	Kind  string                  `json:"kind"`
+	Votes []SideTxVote            `json:"votes"`
+}
+
+// NewMsgSubmitSideTxEvidence creates side-tx evidence msg
+func NewMsgSubmitSideTxEvidence(from hmTypes.HeimdallAddress, id uint64, kind string, votes []SideTxVote) MsgSubmitSideTxEvidence {
+	return MsgSubmitSideTxEvidence{
+		From:  from,
+		ID:    hmTypes.NewValidatorID(id),
+		Kind:  kind,
+		Votes: votes,
+	}
+}
+
+// Type returns message type
+func (msg MsgSubmitSideTxEvidence) Type() string {
+	return "side-tx-evidence"
+}
+
+func (msg MsgSubmitSideTxEvidence) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSubmitSideTxEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgSubmitSideTxEvidence) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSubmitSideTxEvidence) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	count := GetSideTxEvidenceVoteCount(msg.Kind)
+	if count == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid evidence kind %v", msg.Kind)
+	}
+
+	if len(msg.Votes) != count {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Evidence %v needs %v votes, got %v", msg.Kind, count, len(msg.Votes))
+	}
+
+	for _, vote := range msg.Votes {
+		if vote.Result != int32(abci.SideTxResultType_Yes) && vote.Result != int32(abci.SideTxResultType_No) {
+			return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid vote result %v", vote.Result)
+		}
+
+		if len(vote.Data) == 0 {
+			return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Vote data is required")
+		}
+
+		if len(vote.Sig) != SideTxSigLength {
+			return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Vote signature must be %v bytes, got %v", SideTxSigLength, len(vote.Sig))
+		}
+
+		if msg.Kind == SideTxEvidenceConflictingVotes {
+			if vote.Precommit == nil {
+				return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Vote precommit is required")
+			}
+
+			if err := vote.Precommit.ValidateBasic(); err != nil {
+				return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid vote precommit: %v", err)
+			}
+
+			if len(vote.Precommit.Signature) != SideTxSigLength {
+				return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Precommit signature must be %v bytes, got %v", SideTxSigLength, len(vote.Precommit.Signature))
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgSubmitSideTxEvidence) GetSideSignBytes() []byte {
+	return nil
+}
+
+// EvidenceHash returns hash of evidence independent of votes order, signature encoding and submitter
+func (msg MsgSubmitSideTxEvidence) EvidenceHash() []byte {
+	votes := make([]SideTxVote, 0, len(msg.Votes))
+
+	// only signed content identifies misbehaviour, tx hash is signed in precommits of conflicting votes
+	for _, vote := range msg.Votes {
+		signed := SideTxVote{Result: vote.Result, Data: vote.Data}
+		if msg.Kind == SideTxEvidenceConflictingVotes {
+			signed.TxHash = vote.TxHash
+		}
+
+		votes = append(votes, signed)
+	}
+
+	sort.Slice(votes, func(i, j int) bool {
+		return bytes.Compare(votes[i].SignBytes(), votes[j].SignBytes()) < 0
+	})
+
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(MsgSubmitSideTxEvidence{ID: msg.ID, Kind: msg.Kind, Votes: votes}))
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorUptime signed and missed blocks of validator in period of blocks
+type ValidatorUptime struct {
+	StartHeight       int64 `json:"start_height"`
+	EndHeight         int64 `json:"end_height"`
+	Signed            int64 `json:"signed"`
+	Missed            int64 `json:"missed"`
+	MaintenanceMissed int64 `json:"maintenance_missed"` // missed in signalled maintenance window, not in missed
+}
+
+// NewValidatorUptime creates uptime of period starting at height
+func NewValidatorUptime(startHeight int64, endHeight int64) ValidatorUptime {
+	return ValidatorUptime{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// ValidatorUptimeSummary signed and missed blocks of validator over range of periods
+type ValidatorUptimeSummary struct {
+	ID                hmTypes.ValidatorID `json:"ID"`
+	Signed            int64               `json:"signed"`
+	Missed            int64               `json:"missed"`
+	MaintenanceMissed int64               `json:"maintenance_missed"`
+	Uptime            sdk.Dec             `json:"uptime"` // signed share of blocks outside of maintenance windows
+	History           []ValidatorUptime   `json:"history,omitempty"`
+}
+
+// NewValidatorUptimeSummary sums up uptime history of validator
+func NewValidatorUptimeSummary(id hmTypes.ValidatorID, history []ValidatorUptime) ValidatorUptimeSummary {
+	summary := ValidatorUptimeSummary{
+		ID:      id,
+		History: history,
+	}
+
+	for _, uptime := range history {
+		summary.Signed += uptime.Signed
+		summary.Missed += uptime.Missed
+		summary.MaintenanceMissed += uptime.MaintenanceMissed
+	}
+
+	// nothing counted against validator
+	summary.Uptime = sdk.OneDec()
+	if total := summary.Signed + summary.Missed; total > 0 {
+		summary.Uptime = sdk.NewDec(summary.Signed).QuoInt64(total)
+	}
+
+	return summary
+}
+
+// SortUptimeLeaderboard orders summaries by uptime descending, then by signed blocks descending and id
+func SortUptimeLeaderboard(summaries []ValidatorUptimeSummary) {
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if !summaries[i].Uptime.Equal(summaries[j].Uptime) {
+			return summaries[i].Uptime.GT(summaries[j].Uptime)
+		}
+
+		if summaries[i].Signed != summaries[j].Signed {
+			return summaries[i].Signed > summaries[j].Signed
+		}
+
+		return summaries[i].ID < summaries[j].ID
+	})
+}
+
+// QueryUptimeHistoryParams defines the params for the following queries:
+// - 'custom/slashing/uptime-history'
+type QueryUptimeHistoryParams struct {
+	ValidatorID hmTypes.ValidatorID
+	FromHeight  int64
+	ToHeight    int64
+}
+
+// NewQueryUptimeHistoryParams creates a new QueryUptimeHistoryParams instance
+func NewQueryUptimeHistoryParams(valID hmTypes.ValidatorID, fromHeight int64, toHeight int64) QueryUptimeHistoryParams {
+	return QueryUptimeHistoryParams{valID, fromHeight, toHeight}
+}
+
+// QueryUptimeLeaderboardParams defines the params for the following queries:
+// - 'custom/slashing/uptime-leaderboard'
+type QueryUptimeLeaderboardParams struct {
+	FromHeight int64
+	ToHeight   int64
+	Limit      int
+}
+
+// NewQueryUptimeLeaderboardParams creates a new QueryUptimeLeaderboardParams instance
+func NewQueryUptimeLeaderboardParams(fromHeight int64, toHeight int64, limit int) QueryUptimeLeaderboardParams {
+	return QueryUptimeLeaderboardParams{fromHeight, toHeight, limit}
+}
@@ -0,0 +1,188 @@
+package slashing
+
+import (
+	"encoding/binary"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Uptime history
+//
+
+// SetValidatorUptime stores uptime of validator for period
+func (k *Keeper) SetValidatorUptime(ctx sdk.Context, valID hmTypes.ValidatorID, uptime types.ValidatorUptime) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(uptime)
+	store.Set(types.GetValidatorUptimeKey(valID.Uint64(), uptime.StartHeight), bz)
+}
+
+// GetValidatorUptime returns uptime of validator for period starting at height
+func (k *Keeper) GetValidatorUptime(ctx sdk.Context, valID hmTypes.ValidatorID, startHeight int64) (uptime types.ValidatorUptime, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorUptimeKey(valID.Uint64(), startHeight))
+	if bz == nil {
+		return uptime, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &uptime)
+	return uptime, true
+}
+
+// GetValidatorUptimeHistory returns uptime of validator for periods starting between heights, ordered by height
+func (k *Keeper) GetValidatorUptimeHistory(ctx sdk.Context, valID hmTypes.ValidatorID, fromHeight int64, toHeight int64) (history []types.ValidatorUptime) {
+	store := ctx.KVStore(k.storeKey)
+
+	if fromHeight < 0 {
+		fromHeight = 0
+	}
+
+	// zero to height means latest
+	end := sdk.PrefixEndBytes(types.GetValidatorUptimePrefixKey(valID.Uint64()))
+	if toHeight > 0 && toHeight < math.MaxInt64 {
+		end = types.GetValidatorUptimeKey(valID.Uint64(), toHeight+1)
+	}
+
+	iterator := store.Iterator(types.GetValidatorUptimeKey(valID.Uint64(), fromHeight), end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var uptime types.ValidatorUptime
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &uptime)
+		history = append(history, uptime)
+	}
+
+	return history
+}
+
+// GetValidatorUptimeSince returns uptime of validator for periods overlapping heights from fromHeight on,
+// including the period in progress at fromHeight which starts before it
+func (k *Keeper) GetValidatorUptimeSince(ctx sdk.Context, valID hmTypes.ValidatorID, fromHeight int64) (history []types.ValidatorUptime) {
+	store := ctx.KVStore(k.storeKey)
+
+	if fromHeight < 0 {
+		fromHeight = 0
+	}
+
+	// latest period starting before fromHeight
+	iterator := store.ReverseIterator(types.GetValidatorUptimePrefixKey(valID.Uint64()), types.GetValidatorUptimeKey(valID.Uint64(), fromHeight))
+	if iterator.Valid() {
+		var uptime types.ValidatorUptime
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &uptime)
+		if uptime.EndHeight >= fromHeight {
+			history = append(history, uptime)
+		}
+	}
+	iterator.Close()
+
+	return append(history, k.GetValidatorUptimeHistory(ctx, valID, fromHeight, 0)...)
+}
+
+// IterateValidatorUptimes iterates over stored uptimes of all validators ordered by validator id and height
+func (k *Keeper) IterateValidatorUptimes(ctx sdk.Context,
+	handler func(valID hmTypes.ValidatorID, uptime types.ValidatorUptime) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorUptimeKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.ValidatorUptimeKey):]
+		valID := hmTypes.ValidatorID(binary.BigEndian.Uint64(key[:8]))
+
+		var uptime types.ValidatorUptime
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &uptime)
+		if handler(valID, uptime) {
+			break
+		}
+	}
+}
+
+// RecordValidatorUptime counts signed or missed block of validator in its current uptime period
+func (k *Keeper) RecordValidatorUptime(ctx sdk.Context, addr []byte, signed bool) {
+	periodBlocks := k.GetParams(ctx).UptimePeriodBlocks
+	if periodBlocks <= 0 {
+		return
+	}
+
+	validator, err := k.sk.GetValidatorInfo(ctx, addr)
+	if err != nil {
+		k.Logger(ctx).Error("validator info not found", "address", hmTypes.BytesToHeimdallAddress(addr))
+		return
+	}
+
+	height := ctx.BlockHeight()
+	startHeight := height - height%periodBlocks
+
+	uptime, found := k.GetValidatorUptime(ctx, validator.ID, startHeight)
+	if !found {
+		uptime = types.NewValidatorUptime(startHeight, startHeight+periodBlocks-1)
+	}
+
+	signInfo, _ := k.GetValidatorSigningInfo(ctx, validator.ID)
+	switch {
+	case signed:
+		uptime.Signed++
+	case signInfo.InMaintenance(height):
+		uptime.MaintenanceMissed++
+	default:
+		uptime.Missed++
+	}
+
+	k.SetValidatorUptime(ctx, validator.ID, uptime)
+}
+
+// PruneValidatorUptimes deletes uptime periods of all validators older than UptimeHistoryPeriods,
+// runs once per period at its start
+func (k *Keeper) PruneValidatorUptimes(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+	if params.UptimePeriodBlocks <= 0 || height%params.UptimePeriodBlocks != 0 {
+		return
+	}
+
+	// periods ending before cutoff are out of retention
+	cutoff := height - params.UptimeHistoryPeriods*params.UptimePeriodBlocks
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorUptimeKey)
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var uptime types.ValidatorUptime
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &uptime)
+		if uptime.EndHeight < cutoff {
+			keys = append(keys, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetUptimeLeaderboard returns uptime summaries of validators with history between heights, best uptime first
+func (k *Keeper) GetUptimeLeaderboard(ctx sdk.Context, fromHeight int64, toHeight int64) []types.ValidatorUptimeSummary {
+	summaries := []types.ValidatorUptimeSummary{}
+
+	for _, validator := range k.sk.GetAllValidators(ctx) {
+		history := k.GetValidatorUptimeHistory(ctx, validator.ID, fromHeight, toHeight)
+		if len(history) == 0 {
+			continue
+		}
+
+		// leaderboard only carries totals
+		summary := types.NewValidatorUptimeSummary(validator.ID, history)
+		summary.History = nil
+		summaries = append(summaries, summary)
+	}
+
+	types.SortUptimeLeaderboard(summaries)
+
+	return summaries
+}
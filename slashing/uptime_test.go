@@ -0,0 +1,160 @@
+package slashing_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/simulation"
+)
+
+type UptimeTestSuite struct {
+	suite.Suite
+
+	app      *app.HeimdallApp
+	ctx      sdk.Context
+	accounts []simulation.Account
+}
+
+func (suite *UptimeTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{})
+	accounts, validators := chSim.LoadAccountValidators(3, suite.T(), suite.app.StakingKeeper, suite.ctx)
+	suite.accounts = accounts
+
+	keeper := suite.app.SlashingKeeper
+	for _, validator := range validators {
+		keeper.SetValidatorSigningInfo(suite.ctx, validator.ID, hmTypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0))
+	}
+
+	// validator 3 is in maintenance between 11 and 15
+	signInfo, _ := keeper.GetValidatorSigningInfo(suite.ctx, hmTypes.NewValidatorID(3))
+	signInfo.MaintenanceStartHeight, signInfo.MaintenanceEndHeight, signInfo.MaintenanceWindows = 11, 15, 1
+	keeper.SetValidatorSigningInfo(suite.ctx, signInfo.ValID, signInfo)
+
+	params := keeper.GetParams(suite.ctx)
+	params.UptimePeriodBlocks = 10
+	keeper.SetParams(suite.ctx, params)
+
+	// validator 1 signs every block, validator 2 every other block and validator 3 outside of maintenance
+	for height := int64(10); height < 40; height++ {
+		votes := []abci.VoteInfo{}
+		for i, account := range suite.accounts {
+			signed := i == 0 || (i == 1 && height%2 == 0) || (i == 2 && (height < 11 || height > 15))
+			votes = append(votes, abci.VoteInfo{
+				Validator:       abci.Validator{Address: account.Address.Bytes(), Power: 100},
+				SignedLastBlock: signed,
+			})
+		}
+
+		slashing.BeginBlocker(suite.ctx.WithBlockHeight(height), abci.RequestBeginBlock{
+			LastCommitInfo: abci.LastCommitInfo{Votes: votes},
+		}, keeper)
+	}
+}
+
+func TestUptimeTestSuite(t *testing.T) {
+	suite.Run(t, new(UptimeTestSuite))
+}
+
+func (suite *UptimeTestSuite) TestUptimeHistory() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+
+	t.Run("AllPeriods", func(t *testing.T) {
+		history := keeper.GetValidatorUptimeHistory(ctx, hmTypes.NewValidatorID(2), 0, 0)
+		require.Len(t, history, 3)
+
+		for i, uptime := range history {
+			require.Equal(t, int64(10*(i+1)), uptime.StartHeight)
+			require.Equal(t, int64(10*(i+1)+9), uptime.EndHeight)
+			require.Equal(t, int64(5), uptime.Signed)
+			require.Equal(t, int64(5), uptime.Missed)
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		history := keeper.GetValidatorUptimeHistory(ctx, hmTypes.NewValidatorID(1), 20, 29)
+		require.Len(t, history, 1)
+		require.Equal(t, int64(20), history[0].StartHeight)
+		require.Equal(t, int64(10), history[0].Signed)
+	})
+
+	t.Run("Since", func(t *testing.T) {
+		// period in progress at height is included
+		history := keeper.GetValidatorUptimeSince(ctx, hmTypes.NewValidatorID(2), 25)
+		require.Len(t, history, 2)
+		require.Equal(t, int64(20), history[0].StartHeight)
+		require.Equal(t, int64(30), history[1].StartHeight)
+
+		require.Len(t, keeper.GetValidatorUptimeSince(ctx, hmTypes.NewValidatorID(2), 30), 1)
+	})
+
+	t.Run("Maintenance", func(t *testing.T) {
+		summary := slashingTypes.NewValidatorUptimeSummary(hmTypes.NewValidatorID(3), keeper.GetValidatorUptimeHistory(ctx, hmTypes.NewValidatorID(3), 10, 19))
+		require.Equal(t, int64(5), summary.Signed)
+		require.Equal(t, int64(0), summary.Missed)
+		require.Equal(t, int64(5), summary.MaintenanceMissed)
+		require.Equal(t, sdk.OneDec(), summary.Uptime)
+	})
+}
+
+func (suite *UptimeTestSuite) TestUptimeLeaderboard() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+
+	leaderboard := keeper.GetUptimeLeaderboard(ctx, 0, 0)
+	require.Len(t, leaderboard, 3)
+
+	// full uptime ordered by signed blocks
+	require.Equal(t, hmTypes.NewValidatorID(1), leaderboard[0].ID)
+	require.Equal(t, hmTypes.NewValidatorID(3), leaderboard[1].ID)
+	require.Equal(t, hmTypes.NewValidatorID(2), leaderboard[2].ID)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), leaderboard[2].Uptime)
+	require.Nil(t, leaderboard[0].History)
+}
+
+func (suite *UptimeTestSuite) TestUptimeGenesis() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+
+	genesis := slashing.ExportGenesis(ctx, keeper)
+	require.Len(t, genesis.UptimeHistory, 3)
+	require.Len(t, genesis.UptimeHistory["2"], 3)
+
+	other := app.Setup(false)
+	otherCtx := other.BaseApp.NewContext(false, abci.Header{})
+	slashing.InitGenesis(otherCtx, other.SlashingKeeper, genesis)
+
+	require.Equal(t,
+		keeper.GetValidatorUptimeHistory(ctx, hmTypes.NewValidatorID(2), 0, 0),
+		other.SlashingKeeper.GetValidatorUptimeHistory(otherCtx, hmTypes.NewValidatorID(2), 0, 0))
+}
+
+func (suite *UptimeTestSuite) TestPruneValidatorUptimes() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.SlashingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.UptimeHistoryPeriods = 1
+	keeper.SetParams(ctx, params)
+
+	// not at period start
+	slashing.BeginBlocker(ctx.WithBlockHeight(41), abci.RequestBeginBlock{}, keeper)
+	require.Len(t, keeper.GetValidatorUptimeHistory(ctx, hmTypes.NewValidatorID(2), 0, 0), 3)
+
+	// only period before current one is kept
+	slashing.BeginBlocker(ctx.WithBlockHeight(40), abci.RequestBeginBlock{}, keeper)
+	for _, account := range suite.accounts {
+		validator, err := suite.app.StakingKeeper.GetValidatorInfo(ctx, account.Address.Bytes())
+		require.NoError(t, err)
+
+		history := keeper.GetValidatorUptimeHistory(ctx, validator.ID, 0, 0)
+		require.Len(t, history, 1)
+		require.Equal(t, int64(30), history[0].StartHeight)
+	}
+}
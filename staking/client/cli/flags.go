@@ -16,7 +16,13 @@ const (
 	FlagFeeAmount         = "fee-amount"
 	FlagBlockNumber       = "block-number"
 	FlagNonce             = "nonce"
+	FlagRootChain         = "root-chain"
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
+
+	FlagMoniker  = "moniker"
+	FlagWebsite  = "website"
+	FlagContact  = "contact"
+	FlagIdentity = "identity"
 )
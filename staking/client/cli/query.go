@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -31,6 +32,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetValSetAtHeight(cdc),
+			GetValSetAtCheckpoint(cdc),
+			GetDelegatorDelegations(cdc),
+			GetValidatorDelegations(cdc),
+			GetValidatorPowers(cdc),
 		)...,
 	)
 
@@ -104,3 +110,162 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetValSetAtHeight validator set snapshot in effect at height
+func GetValSetAtHeight(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-at-height [height]",
+		Short: "show validator set as of height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetAtHeightParams(height))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetAtHeight), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetValSetAtCheckpoint validator set snapshot which signed checkpoint
+func GetValSetAtCheckpoint(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-at-checkpoint [number]",
+		Short: "show validator set which signed checkpoint number of --root-chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStakingParams(number, viper.GetString(FlagRootChain)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetAtCheckpoint), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagRootChain, hmTypes.RootChainTypeEth, "--root-chain=<root-chain>")
+
+	return cmd
+}
+
+// GetDelegatorDelegations delegations of delegator, optionally on one validator
+func GetDelegatorDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations [delegator-address]",
+		Short: "show delegations of delegator on all validators or on --id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			delegator := hmTypes.HexToHeimdallAddress(args[0])
+			if delegator.Empty() {
+				return fmt.Errorf("invalid delegator address %v", args[0])
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegationParams(delegator, hmTypes.NewValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			route := types.QueryDelegatorDelegations
+			if validatorID != 0 {
+				route = types.QueryDelegation
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, route), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
+
+// GetValidatorDelegations delegator list of validator
+func GetValidatorDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-delegations [validator-id]",
+		Short: "show delegators of validator with their shares and power",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegationParams(hmTypes.ZeroHeimdallAddress, hmTypes.NewValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetValidatorPowers raw and effective power of current validators
+func GetValidatorPowers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-powers",
+		Short: "show raw and capped effective power of current validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorPowers), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
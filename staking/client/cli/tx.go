@@ -40,6 +40,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendValidatorUpdateTx(cdc),
 			SendValidatorExitTx(cdc),
 			SendValidatorStakeUpdateTx(cdc),
+			SendEditValidatorMetadataTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -391,3 +392,43 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SendEditValidatorMetadataTx send edit validator metadata transaction
+func SendEditValidatorMetadataTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-validator-metadata",
+		Short: "Set moniker, website, contact and identity of validator, signed by validator signer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator := viper.GetUint64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			msg := types.NewMsgEditValidatorMetadata(
+				helper.GetFromAddress(cliCtx),
+				hmTypes.NewValidatorID(validator),
+				viper.GetString(FlagMoniker),
+				viper.GetString(FlagWebsite),
+				viper.GetString(FlagContact),
+				viper.GetString(FlagIdentity),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagMoniker, "", "--moniker=<moniker>")
+	cmd.Flags().String(FlagWebsite, "", "--website=<website>")
+	cmd.Flags().String(FlagContact, "", "--contact=<contact>")
+	cmd.Flags().String(FlagIdentity, "", "--identity=<identity-key>")
+
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("SendEditValidatorMetadataTx | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
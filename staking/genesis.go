@@ -8,6 +8,19 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// restore validator set snapshots before current set is stored
+	for _, snapshot := range data.ValidatorSetSnapshots {
+		if err := keeper.SetValidatorSetSnapshot(ctx, snapshot); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, proposal := range data.CheckpointProposals {
+		if err := keeper.SetCheckpointProposal(ctx, proposal); err != nil {
+			panic(err)
+		}
+	}
+
 	// get current val set
 	var vals []*hmTypes.Validator
 	if len(data.CurrentValSet.Validators) == 0 {
@@ -38,10 +51,29 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 
+	for _, metadata := range data.ValidatorMetadata {
+		if err := keeper.SetValidatorMetadata(ctx, metadata); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, delegation := range data.Delegations {
+		if err := keeper.SetDelegation(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, sequence := range data.StakingSequences {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
 
+	for _, queue := range data.StakingQueues {
+		rootID := keeper.chainKeeper.GetRootChainID(ctx, queue.RootChain)
+		for _, record := range queue.Records {
+			keeper.AddStakingRecordToQueue(ctx, rootID, record)
+		}
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -53,5 +85,10 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetStakingSequences(ctx),
+		keeper.GetStakingQueues(ctx),
+		keeper.GetValidatorSetSnapshots(ctx),
+		keeper.GetCheckpointProposals(ctx),
+		keeper.GetAllValidatorMetadata(ctx),
+		keeper.GetAllDelegations(ctx),
 	)
 }
@@ -68,10 +68,11 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	// validator set
 	validatorSet := hmTypes.NewValidatorSet(validators)
 
-	genesisState := types.NewGenesisState(param, validators, *validatorSet, stakingSequence)
+	genesisState := types.NewGenesisState(param, validators, *validatorSet, stakingSequence, nil, nil, nil, nil, nil)
 	staking.InitGenesis(ctx, app.StakingKeeper, genesisState)
 
 	actualParams := staking.ExportGenesis(ctx, app.StakingKeeper)
 	require.NotNil(t, actualParams)
 	require.LessOrEqual(t, 5, len(actualParams.Validators))
+	require.Len(t, actualParams.ValidatorSetSnapshots, 1)
 }
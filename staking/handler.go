@@ -33,6 +33,12 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return handleMsgStakingSync(ctx, msg, k, contractCaller)
 		case types.MsgStakingSyncAck:
 			return handleMsgStakingSyncAck(ctx, msg, k, contractCaller)
+		case types.MsgEditValidatorMetadata:
+			return handleMsgEditValidatorMetadata(ctx, msg, k)
+		case types.MsgDelegate:
+			return HandleMsgDelegate(ctx, msg, k, contractCaller)
+		case types.MsgUndelegate:
+			return HandleMsgUndelegate(ctx, msg, k, contractCaller)
 		default:
 			return sdk.ErrTxDecode("Invalid message in staking module").Result()
 		}
@@ -284,8 +290,14 @@ func handleMsgStakingSync(ctx sdk.Context, msg types.MsgStakingSync, k Keeper, c
 		"root", msg.RootChain,
 	)
 
+	// paused or retired chain doesn't receive staking sync
+	if !k.chainKeeper.IsChainActive(ctx, msg.RootChain) {
+		logger.Error("Root chain is not active", "root", msg.RootChain)
+		return common.ErrChainInactive(k.Codespace()).Result()
+	}
+
 	// Get last staking sync from buffer
-	stakingRecord, err := k.GetNextStakingRecordFromQueue(ctx, hmTypes.GetRootChainID(msg.RootChain))
+	stakingRecord, err := k.GetNextStakingRecordFromQueue(ctx, k.chainKeeper.GetRootChainID(ctx, msg.RootChain))
 	if err != nil || stakingRecord == nil {
 		logger.Error("Unable to get staking record from queue", "error", err)
 		return common.ErrBadAck(k.Codespace()).Result()
@@ -338,7 +350,7 @@ func handleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k Kee
 	)
 
 	// Get last staking sync from buffer
-	stakingRecord, err := k.GetNextStakingRecordFromQueue(ctx, hmTypes.GetRootChainID(msg.RootChain))
+	stakingRecord, err := k.GetNextStakingRecordFromQueue(ctx, k.chainKeeper.GetRootChainID(ctx, msg.RootChain))
 	if err != nil || stakingRecord == nil {
 		logger.Error("Unable to get staking record from queue", "error", err)
 		return common.ErrBadAck(k.Codespace()).Result()
@@ -366,3 +378,131 @@ func handleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k Kee
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleMsgEditValidatorMetadata sets validator metadata, msg has to be signed by validator signer
+func handleMsgEditValidatorMetadata(ctx sdk.Context, msg types.MsgEditValidatorMetadata, k Keeper) sdk.Result {
+	logger := k.Logger(ctx)
+	logger.Debug("✅ Validating edit validator metadata msg",
+		"validatorId", msg.ValidatorID,
+		"from", msg.From,
+	)
+
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ValidatorID)
+	if !ok {
+		logger.Error("Fetching of validator from store failed", "validatorId", msg.ValidatorID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		logger.Error("Msg is not signed by validator signer",
+			"validatorId", msg.ValidatorID,
+			"signer", validator.Signer.String(),
+			"from", msg.From.String())
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	if err := k.SetValidatorMetadata(ctx, msg.GetMetadata()); err != nil {
+		logger.Error("Unable to save validator metadata", "validatorId", msg.ValidatorID, "error", err)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditValidatorMetadata,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ValidatorID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyMoniker, msg.Moniker),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgDelegate handle msg delegate
+func HandleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating delegate msg",
+		"validatorID", msg.ID,
+		"delegator", msg.Delegator,
+		"shares", msg.Shares,
+		"amount", msg.Amount,
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	if _, ok := k.GetValidatorFromValID(ctx, msg.ID); !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// sequence id
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgUndelegate handle msg undelegate
+func HandleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating undelegate msg",
+		"validatorID", msg.ID,
+		"delegator", msg.Delegator,
+		"shares", msg.Shares,
+		"amount", msg.Amount,
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// delegations made before they were tracked are missing, their burns are accepted as no-op
+	delegation, ok := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if ok && delegation.Shares.LT(msg.Shares) {
+		k.Logger(ctx).Error("Burned shares exceed delegation shares", "shares", delegation.Shares, "burned", msg.Shares)
+		return hmCommon.ErrInsufficientShares(k.Codespace()).Result()
+	}
+
+	// sequence id
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -367,3 +367,43 @@ func (suite *HandlerTestSuite) TestTopupSuccessBeforeValidatorJoin() {
 	require.True(t, result.IsOK(), "expected validator stake update to be ok, got %v", result)
 
 }
+
+func (suite *HandlerTestSuite) TestHandleMsgEditValidatorMetadata() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	valSet := chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	msg := types.NewMsgEditValidatorMetadata(validator.Signer, validator.ID, "moniker", "https://example.com", "ops@example.com", "ABCDEF0123456789")
+	require.NoError(t, msg.ValidateBasic())
+
+	result := suite.handler(ctx, msg)
+	require.True(t, result.IsOK(), "expected edit validator metadata to be ok, got %v", result)
+
+	metadata, found := keeper.GetValidatorMetadata(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, msg.GetMetadata(), metadata)
+
+	// msg not signed by validator signer
+	msg.From = valSet.Validators[1].Signer
+	result = suite.handler(ctx, msg)
+	require.Equal(t, errs.CodeValSignerMismatch, result.Code)
+
+	// unknown validator
+	msg = types.NewMsgEditValidatorMetadata(validator.Signer, hmTypes.NewValidatorID(1000), "moniker", "", "", "")
+	result = suite.handler(ctx, msg)
+	require.Equal(t, errs.CodeNoValidator, result.Code)
+
+	// moniker too long
+	msg = types.NewMsgEditValidatorMetadata(validator.Signer, validator.ID, string(make([]byte, types.MaxMonikerLength+1)), "", "", "")
+	require.Error(t, msg.ValidateBasic())
+
+	// empty metadata removes it
+	msg = types.NewMsgEditValidatorMetadata(validator.Signer, validator.ID, "", "", "", "")
+	result = suite.handler(ctx, msg)
+	require.True(t, result.IsOK())
+
+	_, found = keeper.GetValidatorMetadata(ctx, validator.ID)
+	require.False(t, found)
+}
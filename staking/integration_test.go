@@ -21,7 +21,12 @@ func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLICo
 		stakingTypes.DefaultGenesisState().Params,
 		stakingTypes.DefaultGenesisState().Validators,
 		stakingTypes.DefaultGenesisState().CurrentValSet,
-		stakingTypes.DefaultGenesisState().StakingSequences)
+		stakingTypes.DefaultGenesisState().StakingSequences,
+		stakingTypes.DefaultGenesisState().StakingQueues,
+		stakingTypes.DefaultGenesisState().ValidatorSetSnapshots,
+		stakingTypes.DefaultGenesisState().CheckpointProposals,
+		stakingTypes.DefaultGenesisState().ValidatorMetadata,
+		stakingTypes.DefaultGenesisState().Delegations)
 
 	app := app.Setup(isCheckTx)
 	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
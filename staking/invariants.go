@@ -0,0 +1,98 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "validator-set", ValidatorSetInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "validator-id", ValidatorIDInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the staking module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ValidatorSetInvariant(keeper)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ValidatorIDInvariant(keeper)(ctx)
+	}
+}
+
+// ValidatorSetInvariant checks that the stored validator set holds exactly the
+// current validators of GetAllValidators with their voting power
+func ValidatorSetInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		ackCount := keeper.moduleCommunicator.GetACKCount(ctx)
+		validatorSet := keeper.GetValidatorSet(ctx)
+
+		currentCount := 0
+
+		for _, validator := range keeper.GetAllValidators(ctx) {
+			_, setValidator := validatorSet.GetByAddress(validator.Signer.Bytes())
+
+			if !validator.IsCurrentValidator(ackCount) {
+				if setValidator != nil {
+					broken = true
+					msg += fmt.Sprintf("\tvalidator %d is not current but in validator set\n", validator.ID)
+				}
+
+				continue
+			}
+
+			currentCount++
+
+			switch {
+			case setValidator == nil:
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %d is current but not in validator set\n", validator.ID)
+			case setValidator.VotingPower != validator.VotingPower:
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %d has power %d but %d in validator set\n",
+					validator.ID, validator.VotingPower, setValidator.VotingPower)
+			}
+		}
+
+		if len(validatorSet.Validators) != currentCount {
+			broken = true
+			msg += fmt.Sprintf("\tvalidator set size %d but %d current validators\n", len(validatorSet.Validators), currentCount)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator-set", msg), broken
+	}
+}
+
+// ValidatorIDInvariant checks that every validator id maps back to the validator's signer
+func ValidatorIDInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		keeper.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
+			signer, ok := keeper.GetSignerFromValidatorID(ctx, validator.ID)
+			if !ok || !hmTypes.BytesToHeimdallAddress(signer.Bytes()).Equals(validator.Signer) {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %d signer %s mapped to %s\n", validator.ID, validator.Signer, signer.Hex())
+			}
+
+			return nil
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "validator-id", msg), broken
+	}
+}
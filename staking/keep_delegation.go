@@ -0,0 +1,176 @@
+package staking
+
+//
+// Delegation
+//
+
+import (
+	"encoding/binary"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func getValidatorIDBytes(validatorID hmTypes.ValidatorID) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, validatorID.Uint64())
+
+	return idBytes
+}
+
+func getValidatorDelegationsKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(append([]byte{}, DelegationKey...), getValidatorIDBytes(validatorID)...)
+}
+
+// GetDelegationKey returns key of delegation: validator id and delegator address
+func GetDelegationKey(validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) []byte {
+	return append(getValidatorDelegationsKey(validatorID), delegator.Bytes()...)
+}
+
+func getDelegatorDelegationsKey(delegator hmTypes.HeimdallAddress) []byte {
+	return append(append([]byte{}, DelegatorIndexKey...), delegator.Bytes()...)
+}
+
+// GetDelegatorIndexKey returns key of delegator index: delegator address and validator id
+func GetDelegatorIndexKey(delegator hmTypes.HeimdallAddress, validatorID hmTypes.ValidatorID) []byte {
+	return append(getDelegatorDelegationsKey(delegator), getValidatorIDBytes(validatorID)...)
+}
+
+// SetDelegation stores delegation, delegation without shares is removed
+func (k *Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if delegation.Shares.IsZero() {
+		store.Delete(GetDelegationKey(delegation.ValidatorID, delegation.DelegatorAddress))
+		store.Delete(GetDelegatorIndexKey(delegation.DelegatorAddress, delegation.ValidatorID))
+
+		return nil
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(delegation)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetDelegationKey(delegation.ValidatorID, delegation.DelegatorAddress), bz)
+	store.Set(GetDelegatorIndexKey(delegation.DelegatorAddress, delegation.ValidatorID), DefaultValue)
+
+	return nil
+}
+
+// GetDelegation returns delegation of delegator on validator
+func (k *Keeper) GetDelegation(ctx sdk.Context, validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) (delegation types.Delegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetDelegationKey(validatorID, delegator))
+	if bz == nil {
+		return delegation, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &delegation); err != nil {
+		k.Logger(ctx).Error("GetDelegation | UnmarshalBinaryBare", "error", err)
+		return delegation, false
+	}
+
+	return delegation, true
+}
+
+// GetValidatorDelegations returns delegations on validator ordered by delegator address
+func (k *Keeper) GetValidatorDelegations(ctx sdk.Context, validatorID hmTypes.ValidatorID) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, getValidatorDelegationsKey(validatorID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &delegation); err != nil {
+			k.Logger(ctx).Error("GetValidatorDelegations | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetDelegatorDelegations returns delegations of delegator ordered by validator id
+func (k *Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator hmTypes.HeimdallAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := getDelegatorDelegationsKey(delegator)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validatorID := hmTypes.NewValidatorID(binary.BigEndian.Uint64(iterator.Key()[len(prefix):]))
+		if delegation, found := k.GetDelegation(ctx, validatorID, delegator); found {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations
+}
+
+// GetAllDelegations returns all delegations ordered by validator id
+func (k *Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, DelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &delegation); err != nil {
+			k.Logger(ctx).Error("GetAllDelegations | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// AddDelegationShares adds minted shares and delegated tokens to delegation
+func (k *Keeper) AddDelegationShares(ctx sdk.Context, validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, shares, amount sdk.Int, lastUpdated string) (types.Delegation, error) {
+	delegation, found := k.GetDelegation(ctx, validatorID, delegator)
+	if !found {
+		delegation = types.NewDelegation(delegator, validatorID, sdk.ZeroInt(), sdk.ZeroInt(), "")
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	delegation.Amount = delegation.Amount.Add(amount)
+	delegation.LastUpdated = lastUpdated
+
+	return delegation, k.SetDelegation(ctx, delegation)
+}
+
+// RemoveDelegationShares removes burned shares and withdrawn tokens from delegation
+func (k *Keeper) RemoveDelegationShares(ctx sdk.Context, validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, shares, amount sdk.Int, lastUpdated string) (types.Delegation, error) {
+	delegation, found := k.GetDelegation(ctx, validatorID, delegator)
+	if !found {
+		return delegation, errors.New("Delegation not found")
+	}
+
+	if delegation.Shares.LT(shares) {
+		return delegation, errors.New("Insufficient delegation shares")
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	delegation.LastUpdated = lastUpdated
+
+	// amount tracks delegated tokens, rewards can make withdrawn tokens exceed it
+	if delegation.Shares.IsZero() || delegation.Amount.LT(amount) {
+		delegation.Amount = sdk.ZeroInt()
+	} else {
+		delegation.Amount = delegation.Amount.Sub(amount)
+	}
+
+	return delegation, k.SetDelegation(ctx, delegation)
+}
@@ -5,6 +5,8 @@ package staking
 //
 
 import (
+	"encoding/binary"
+
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
@@ -20,120 +22,231 @@ func getStakingQueueKey(rootID byte) []byte {
 	return append(stakingSendingQueueKey, rootID)
 }
 
+// getStakingQueueRecordID returns id of record in queue: validator id and nonce
+func getStakingQueueRecordID(validatorID hmTypes.ValidatorID, nonce uint64) []byte {
+	recordID := make([]byte, 16)
+	binary.BigEndian.PutUint64(recordID[:8], validatorID.Uint64())
+	binary.BigEndian.PutUint64(recordID[8:], nonce)
+
+	return recordID
+}
+
+func getStakingQueueRecordKey(rootID byte, recordID []byte) []byte {
+	key := append([]byte{}, StakingQueueRecordKey...)
+	key = append(key, rootID)
+
+	return append(key, recordID...)
+}
+
+func getStakingQueueOrderPrefixKey(rootID byte) []byte {
+	return append(append([]byte{}, StakingQueueOrderKey...), rootID)
+}
+
+func getStakingQueueOrderKey(rootID byte, seq uint64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, seq)
+
+	return append(getStakingQueueOrderPrefixKey(rootID), seqBytes...)
+}
+
+func getStakingQueueSeqKey(rootID byte) []byte {
+	return append(append([]byte{}, StakingQueueSeqKey...), rootID)
+}
+
+// nextStakingQueueSeq returns sequence for next record in root queue and increments it
+func (k *Keeper) nextStakingQueueSeq(ctx sdk.Context, rootID byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	key := getStakingQueueSeqKey(rootID)
+
+	var seq uint64
+	if bz := store.Get(key); len(bz) == 8 {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+
+	next := make([]byte, 8)
+	binary.BigEndian.PutUint64(next, seq+1)
+	store.Set(key, next)
+
+	return seq
+}
+
 // AddStakingRecordToQueue adds staking record to root cueue
 func (k *Keeper) AddStakingRecordToQueue(ctx sdk.Context, rootID byte, stakingRecord stakingTypes.StakingRecord) {
-	key := getStakingQueueKey(rootID)
 	store := ctx.KVStore(k.storeKey)
 
-	var records []stakingTypes.StakingRecord
-	if store.Has(key) {
-		err := k.cdc.UnmarshalBinaryBare(store.Get(key), &records)
-		if err != nil {
-			k.Logger(ctx).Error("Error unmarshalling staking queue record", "root", rootID, "error", err)
-			return
-		}
+	recordID := getStakingQueueRecordID(stakingRecord.ValidatorID, stakingRecord.Nonce)
+	recordKey := getStakingQueueRecordKey(rootID, recordID)
+	if store.Has(recordKey) {
+		k.Logger(ctx).Error("Staking record already in queue", "root", rootID,
+			"validator", stakingRecord.ValidatorID, "nonce", stakingRecord.Nonce)
+		return
 	}
-	records = append(records, stakingRecord)
-	out, err := k.cdc.MarshalBinaryBare(records)
+
+	out, err := k.cdc.MarshalBinaryBare(stakingRecord)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling staking queue record", "error", err)
 		return
 	}
-	store.Set(key, out)
+
+	store.Set(recordKey, out)
+	store.Set(getStakingQueueOrderKey(rootID, k.nextStakingQueueSeq(ctx, rootID)), recordID)
 }
 
-// GetNextStakingRecordFromQueue
-func (k *Keeper) GetNextStakingRecordFromQueue(ctx sdk.Context, rootID byte) (*stakingTypes.StakingRecord, error) {
-	key := getStakingQueueKey(rootID)
+// IterateStakingQueueAndApplyFn iterates root queue in order and applies the given function,
+// iteration stops when function returns true
+func (k *Keeper) IterateStakingQueueAndApplyFn(ctx sdk.Context, rootID byte, f func(orderKey []byte, record stakingTypes.StakingRecord) (stop bool)) error {
 	store := ctx.KVStore(k.storeKey)
 
-	var records []stakingTypes.StakingRecord
-	if store.Has(key) {
-		err := k.cdc.UnmarshalBinaryBare(store.Get(key), &records)
-		if err != nil {
+	iterator := sdk.KVStorePrefixIterator(store, getStakingQueueOrderPrefixKey(rootID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record stakingTypes.StakingRecord
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(getStakingQueueRecordKey(rootID, iterator.Value())), &record); err != nil {
 			k.Logger(ctx).Error("Error unmarshalling staking queue record", "root", rootID, "error", err)
-			return nil, err
+			return err
+		}
+
+		if f(iterator.Key(), record) {
+			return nil
 		}
-		return &records[0], nil
 	}
-	return nil, nil
+
+	return nil
+}
+
+// GetNextStakingRecordFromQueue
+func (k *Keeper) GetNextStakingRecordFromQueue(ctx sdk.Context, rootID byte) (*stakingTypes.StakingRecord, error) {
+	var next *stakingTypes.StakingRecord
+
+	err := k.IterateStakingQueueAndApplyFn(ctx, rootID, func(_ []byte, record stakingTypes.StakingRecord) bool {
+		next = &record
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return next, nil
 }
 
 // GetStakingQueue
 func (k *Keeper) GetStakingQueue(ctx sdk.Context, rootID byte) ([]stakingTypes.StakingRecord, error) {
-	key := getStakingQueueKey(rootID)
-	store := ctx.KVStore(k.storeKey)
-
 	var records []stakingTypes.StakingRecord
-	if store.Has(key) {
-		err := k.cdc.UnmarshalBinaryBare(store.Get(key), &records)
-		if err != nil {
-			k.Logger(ctx).Error("Error unmarshalling staking queue record", "root", rootID, "error", err)
-			return nil, err
-		}
-		return records, nil
+
+	err := k.IterateStakingQueueAndApplyFn(ctx, rootID, func(_ []byte, record stakingTypes.StakingRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil, nil
+
+	return records, nil
 }
 
-// removeStakingRecordFromQueue
+// removeStakingRecordFromQueue removes record and all records queued before it
 func (k *Keeper) removeStakingRecordFromQueue(ctx sdk.Context, rootID byte, validatorID hmTypes.ValidatorID, nonce uint64) {
-	key := getStakingQueueKey(rootID)
 	store := ctx.KVStore(k.storeKey)
 
-	var records []stakingTypes.StakingRecord
-	if !store.Has(key) {
+	if !store.Has(getStakingQueueRecordKey(rootID, getStakingQueueRecordID(validatorID, nonce))) {
 		return
 	}
-	err := k.cdc.UnmarshalBinaryBare(store.Get(key), &records)
-	if err != nil {
-		k.Logger(ctx).Error("Error unmarshalling staking queue record", "root", rootID, "error", err)
+
+	var orderKeys [][]byte
+
+	if err := k.IterateStakingQueueAndApplyFn(ctx, rootID, func(orderKey []byte, record stakingTypes.StakingRecord) bool {
+		orderKeys = append(orderKeys, orderKey)
+		return record.ValidatorID == validatorID && record.Nonce == nonce
+	}); err != nil {
 		return
 	}
 
-	for index, record := range records {
-		if record.ValidatorID == validatorID && record.Nonce == nonce {
-			if index+1 == len(records) {
-				store.Delete(key)
-				return
-			}
-			results := records[index+1:]
-			out, err := k.cdc.MarshalBinaryBare(results)
-			if err != nil {
-				k.Logger(ctx).Error("Error marshalling staking queue record", "error", err)
-				return
-			}
-			store.Set(key, out)
-			return
-		}
+	for _, orderKey := range orderKeys {
+		store.Delete(getStakingQueueRecordKey(rootID, store.Get(orderKey)))
+		store.Delete(orderKey)
 	}
 }
 
 // UpdateStakingRecordTimestamp update staking record timestamp
 func (k *Keeper) UpdateStakingRecordTimestamp(ctx sdk.Context, rootID byte, validatorID hmTypes.ValidatorID, nonce uint64, timestamp uint64) {
-	key := getStakingQueueKey(rootID)
 	store := ctx.KVStore(k.storeKey)
 
-	var records []stakingTypes.StakingRecord
-	if !store.Has(key) {
+	recordKey := getStakingQueueRecordKey(rootID, getStakingQueueRecordID(validatorID, nonce))
+	if !store.Has(recordKey) {
 		return
 	}
-	err := k.cdc.UnmarshalBinaryBare(store.Get(key), &records)
-	if err != nil {
+
+	var record stakingTypes.StakingRecord
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(recordKey), &record); err != nil {
 		k.Logger(ctx).Error("Error unmarshalling staking queue record", "root", rootID, "error", err)
 		return
 	}
 
-	for index, record := range records {
-		if record.ValidatorID == validatorID && record.Nonce == nonce {
-			records[index].TimeStamp = timestamp
-			out, err := k.cdc.MarshalBinaryBare(records)
-			if err != nil {
-				k.Logger(ctx).Error("Error marshalling staking queue record", "error", err)
-				return
-			}
-			store.Set(key, out)
-			return
+	record.TimeStamp = timestamp
+
+	out, err := k.cdc.MarshalBinaryBare(record)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling staking queue record", "error", err)
+		return
+	}
+
+	store.Set(recordKey, out)
+}
+
+// GetStakingQueues returns queues of all root chains with pending records
+func (k *Keeper) GetStakingQueues(ctx sdk.Context) []stakingTypes.StakingQueue {
+	var queues []stakingTypes.StakingQueue
+
+	for _, rootChainID := range k.chainKeeper.GetRootChainIDs(ctx) {
+		records, err := k.GetStakingQueue(ctx, rootChainID.ChainID)
+		if err != nil || len(records) == 0 {
+			continue
 		}
+
+		queues = append(queues, stakingTypes.StakingQueue{
+			RootChain: rootChainID.RootChainType,
+			Records:   records,
+		})
+	}
+
+	return queues
+}
+
+// MigrateStakingQueues moves root queues stored as single list to per record storage,
+// it runs once as StakingQueueMigration upgrade
+func (k *Keeper) MigrateStakingQueues(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, stakingSendingQueueKey)
+
+	legacyQueues := make(map[byte][]byte)
+	for ; iterator.Valid(); iterator.Next() {
+		if key := iterator.Key(); len(key) == len(stakingSendingQueueKey)+1 {
+			legacyQueues[key[len(stakingSendingQueueKey)]] = iterator.Value()
+		}
+	}
+	iterator.Close()
+
+	for rootID := byte(0); len(legacyQueues) != 0; rootID++ {
+		bz, ok := legacyQueues[rootID]
+		if !ok {
+			continue
+		}
+
+		delete(legacyQueues, rootID)
+
+		var records []stakingTypes.StakingRecord
+		if err := k.cdc.UnmarshalBinaryBare(bz, &records); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling legacy staking queue", "root", rootID, "error", err)
+			continue
+		}
+
+		for _, record := range records {
+			k.AddStakingRecordToQueue(ctx, rootID, record)
+		}
+
+		store.Delete(getStakingQueueKey(rootID))
+		k.Logger(ctx).Info("Migrated staking queue", "root", rootID, "records", len(records))
 	}
 }
@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 
@@ -19,12 +20,20 @@ import (
 var (
 	DefaultValue = []byte{0x01} // Value to store in CacheCheckpoint and CacheCheckpointACK & ValidatorSetChange Flag
 
-	ValidatorsKey          = []byte{0x21} // prefix for each key to a validator
-	ValidatorMapKey        = []byte{0x22} // prefix for each key for validator map
-	CurrentValidatorSetKey = []byte{0x23} // Key to store current validator set
-	StakingSequenceKey     = []byte{0x24} // prefix for each key for staking sequence map
-
-	stakingSendingQueueKey = []byte{0x31} // prefix key for when storing staking sending queue
+	ValidatorsKey           = []byte{0x21} // prefix for each key to a validator
+	ValidatorMapKey         = []byte{0x22} // prefix for each key for validator map
+	CurrentValidatorSetKey  = []byte{0x23} // Key to store current validator set
+	StakingSequenceKey      = []byte{0x24} // prefix for each key for staking sequence map
+	ValidatorSetSnapshotKey = []byte{0x25} // prefix for each key for validator set snapshot by height
+	ValidatorMetadataKey    = []byte{0x26} // prefix for each key for validator metadata
+	DelegationKey           = []byte{0x27} // prefix for each key for delegation by validator and delegator
+	DelegatorIndexKey       = []byte{0x28} // prefix for each key for delegator to validator index
+	CheckpointProposalKey   = []byte{0x29} // prefix for each key for checkpoint proposal height by root chain and number
+
+	stakingSendingQueueKey = []byte{0x31} // legacy prefix key for staking sending queue stored as single list
+	StakingQueueRecordKey  = []byte{0x32} // prefix key for each staking queue record
+	StakingQueueOrderKey   = []byte{0x33} // prefix key for staking queue order
+	StakingQueueSeqKey     = []byte{0x34} // prefix key for next staking queue sequence
 
 	//ACKCountKey         = []byte{0x11} // key to store ACK count
 	//BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
@@ -204,6 +213,16 @@ func (k *Keeper) GetTotalPower(ctx sdk.Context) (totalPower int64) {
 	return
 }
 
+// CapValidatorPowers returns effective powers under current max validator power percent
+func (k *Keeper) CapValidatorPowers(ctx sdk.Context, powers []int64) []int64 {
+	return types.CapValidatorPowers(powers, k.GetParams(ctx).MaxValidatorPowerPercent)
+}
+
+// GetValidatorPowers returns raw and effective powers of current validator set
+func (k *Keeper) GetValidatorPowers(ctx sdk.Context) types.ValidatorPowers {
+	return types.NewValidatorPowers(k.GetValidatorSet(ctx).Validators, k.GetParams(ctx).MaxValidatorPowerPercent)
+}
+
 // GetSpanEligibleValidators returns current validators who are not getting deactivated in between next span
 func (k *Keeper) GetSpanEligibleValidators(ctx sdk.Context) (validators []hmTypes.Validator) {
 	// get ack count
@@ -296,7 +315,9 @@ func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet hmTy
 
 	// set validator set with CurrentValidatorSetKey as key in store
 	store.Set(CurrentValidatorSetKey, bz)
-	return nil
+
+	// keep snapshot if validators changed
+	return k.snapshotValidatorSet(ctx, newValidatorSet)
 }
 
 // GetValidatorSet returns current Validator Set from store
@@ -314,6 +335,262 @@ func (k *Keeper) GetValidatorSet(ctx sdk.Context) (validatorSet hmTypes.Validato
 	return validatorSet
 }
 
+// GetValidatorSetSnapshotKey returns key of validator set snapshot taken at height
+func GetValidatorSetSnapshotKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+
+	return append(append([]byte{}, ValidatorSetSnapshotKey...), heightBytes...)
+}
+
+// snapshotValidatorSet stores snapshot of validator set when validators, signers or power changed
+func (k *Keeper) snapshotValidatorSet(ctx sdk.Context, validatorSet hmTypes.ValidatorSet) error {
+	snapshot := types.NewValidatorSetSnapshot(ctx.BlockHeight(), validatorSet)
+
+	if last, found := k.GetValidatorSetSnapshotAtHeight(ctx, ctx.BlockHeight()); found && last.SameValidators(snapshot) {
+		return nil
+	}
+
+	if err := k.SetValidatorSetSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+
+	k.pruneValidatorSetSnapshots(ctx)
+
+	return nil
+}
+
+// pruneValidatorSetSnapshots removes snapshots older than latest MaxValidatorSetSnapshots
+func (k *Keeper) pruneValidatorSetSnapshots(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, ValidatorSetSnapshotKey)
+
+	var pruned [][]byte
+	for kept := 0; iterator.Valid(); iterator.Next() {
+		if kept < types.MaxValidatorSetSnapshots {
+			kept++
+			continue
+		}
+
+		pruned = append(pruned, iterator.Key())
+	}
+
+	iterator.Close()
+
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// SetValidatorSetSnapshot stores validator set snapshot
+func (k *Keeper) SetValidatorSetSnapshot(ctx sdk.Context, snapshot types.ValidatorSetSnapshot) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(snapshot)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorSetSnapshotKey(snapshot.Height), bz)
+
+	return nil
+}
+
+// GetValidatorSetSnapshotAtHeight returns validator set snapshot in effect at height
+func (k *Keeper) GetValidatorSetSnapshotAtHeight(ctx sdk.Context, height int64) (snapshot types.ValidatorSetSnapshot, found bool) {
+	if height < 0 {
+		return snapshot, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// latest snapshot taken at or before height
+	iterator := store.ReverseIterator(ValidatorSetSnapshotKey, GetValidatorSetSnapshotKey(height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return snapshot, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err != nil {
+		k.Logger(ctx).Error("GetValidatorSetSnapshotAtHeight | UnmarshalBinaryBare", "error", err)
+		return snapshot, false
+	}
+
+	return snapshot, true
+}
+
+// GetValidatorSetSnapshotAtCheckpoint returns validator set snapshot which signed checkpoint number of root chain,
+// i.e. the one in effect before block checkpoint got proposed in
+func (k *Keeper) GetValidatorSetSnapshotAtCheckpoint(ctx sdk.Context, rootChain string, number uint64) (snapshot types.ValidatorSetSnapshot, found bool) {
+	proposal, found := k.GetCheckpointProposal(ctx, rootChain, number)
+	if !found {
+		return snapshot, false
+	}
+
+	return k.GetValidatorSetSnapshotAtHeight(ctx, proposal.Height-1)
+}
+
+// GetValidatorSetSnapshots returns all validator set snapshots ordered by height
+func (k *Keeper) GetValidatorSetSnapshots(ctx sdk.Context) (snapshots []types.ValidatorSetSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSetSnapshotKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.ValidatorSetSnapshot
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err != nil {
+			k.Logger(ctx).Error("GetValidatorSetSnapshots | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// GetCheckpointProposalKey returns key of proposal height of checkpoint number of root chain
+func GetCheckpointProposalKey(rootID byte, number uint64) []byte {
+	numberBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(numberBytes, number)
+
+	return append(append([]byte{}, CheckpointProposalKey...), append([]byte{rootID}, numberBytes...)...)
+}
+
+// RecordCheckpointProposal stores current height as proposal height of checkpoint number of root chain,
+// records older than latest MaxCheckpointProposals of root chain are removed
+func (k *Keeper) RecordCheckpointProposal(ctx sdk.Context, rootChain string, number uint64) error {
+	if err := k.SetCheckpointProposal(ctx, types.CheckpointProposal{
+		RootChain: rootChain,
+		Number:    number,
+		Height:    ctx.BlockHeight(),
+	}); err != nil {
+		return err
+	}
+
+	if number > types.MaxCheckpointProposals {
+		rootID := k.chainKeeper.GetRootChainID(ctx, rootChain)
+		ctx.KVStore(k.storeKey).Delete(GetCheckpointProposalKey(rootID, number-types.MaxCheckpointProposals))
+	}
+
+	return nil
+}
+
+// SetCheckpointProposal stores checkpoint proposal height
+func (k *Keeper) SetCheckpointProposal(ctx sdk.Context, proposal types.CheckpointProposal) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(proposal)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetCheckpointProposalKey(k.chainKeeper.GetRootChainID(ctx, proposal.RootChain), proposal.Number), bz)
+
+	return nil
+}
+
+// GetCheckpointProposal returns proposal height of checkpoint number of root chain
+func (k *Keeper) GetCheckpointProposal(ctx sdk.Context, rootChain string, number uint64) (proposal types.CheckpointProposal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetCheckpointProposalKey(k.chainKeeper.GetRootChainID(ctx, rootChain), number))
+	if bz == nil {
+		return proposal, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &proposal); err != nil {
+		k.Logger(ctx).Error("GetCheckpointProposal | UnmarshalBinaryBare", "error", err)
+		return proposal, false
+	}
+
+	return proposal, true
+}
+
+// GetCheckpointProposals returns all checkpoint proposal heights ordered by root chain and number
+func (k *Keeper) GetCheckpointProposals(ctx sdk.Context) (proposals []types.CheckpointProposal) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, CheckpointProposalKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.CheckpointProposal
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &proposal); err != nil {
+			k.Logger(ctx).Error("GetCheckpointProposals | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals
+}
+
+// GetValidatorMetadataKey returns key of validator metadata
+func GetValidatorMetadataKey(id hmTypes.ValidatorID) []byte {
+	return append(append([]byte{}, ValidatorMetadataKey...), id.Bytes()...)
+}
+
+// SetValidatorMetadata stores validator metadata, empty metadata removes it
+func (k *Keeper) SetValidatorMetadata(ctx sdk.Context, metadata types.ValidatorMetadata) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if metadata.Empty() {
+		store.Delete(GetValidatorMetadataKey(metadata.ID))
+		return nil
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(metadata)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorMetadataKey(metadata.ID), bz)
+
+	return nil
+}
+
+// GetValidatorMetadata returns validator metadata
+func (k *Keeper) GetValidatorMetadata(ctx sdk.Context, id hmTypes.ValidatorID) (metadata types.ValidatorMetadata, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetValidatorMetadataKey(id))
+	if bz == nil {
+		return metadata, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &metadata); err != nil {
+		k.Logger(ctx).Error("GetValidatorMetadata | UnmarshalBinaryBare", "error", err)
+		return metadata, false
+	}
+
+	return metadata, true
+}
+
+// GetAllValidatorMetadata returns metadata of all validators
+func (k *Keeper) GetAllValidatorMetadata(ctx sdk.Context) (metadataList []types.ValidatorMetadata) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorMetadataKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.ValidatorMetadata
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &metadata); err != nil {
+			k.Logger(ctx).Error("GetAllValidatorMetadata | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		metadataList = append(metadataList, metadata)
+	}
+
+	return metadataList
+}
+
 // IncrementAccum increments accum for validator set by n times and replace validator set in store
 func (k *Keeper) IncrementAccum(ctx sdk.Context, times int) {
 	// get validator set
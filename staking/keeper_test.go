@@ -405,3 +405,236 @@ func (suite *KeeperTestSuite) TestUpdateStakingSync() {
 	fmt.Println(stakingBufferTime)
 	require.Equal(t, result.TimeStamp >= now && result.TimeStamp-now < stakingBufferTime, true)
 }
+
+func (suite *KeeperTestSuite) TestStakingQueue() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	k := app.StakingKeeper
+
+	rootChainID := hmTypes.GetRootChainID(hmTypes.RootChainTypeBsc)
+
+	// empty queue
+	result, err := k.GetNextStakingRecordFromQueue(ctx, rootChainID)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	records := make([]stakingTypes.StakingRecord, 3)
+	for i := range records {
+		records[i] = stakingTypes.StakingRecord{
+			Type:        "validatorJoin",
+			ValidatorID: hmTypes.ValidatorID(10 - i),
+			Nonce:       uint64(i + 1),
+			Height:      ctx.BlockHeight(),
+			TxHash:      hmTypes.ZeroHeimdallHash,
+		}
+		k.AddStakingRecordToQueue(ctx, rootChainID, records[i])
+	}
+
+	// duplicate record is not queued twice
+	k.AddStakingRecordToQueue(ctx, rootChainID, records[1])
+
+	// queue keeps insertion order
+	queue, err := k.GetStakingQueue(ctx, rootChainID)
+	require.NoError(t, err)
+	require.Equal(t, records, queue)
+
+	result, err = k.GetNextStakingRecordFromQueue(ctx, rootChainID)
+	require.NoError(t, err)
+	require.Equal(t, records[0], *result)
+
+	k.UpdateStakingRecordTimestamp(ctx, rootChainID, records[2].ValidatorID, records[2].Nonce, 100)
+	queue, _ = k.GetStakingQueue(ctx, rootChainID)
+	require.Equal(t, uint64(100), queue[2].TimeStamp)
+
+	require.Len(t, k.GetStakingQueues(ctx), 1)
+	require.Equal(t, hmTypes.RootChainTypeBsc, k.GetStakingQueues(ctx)[0].RootChain)
+}
+
+func (suite *KeeperTestSuite) TestMigrateStakingQueues() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	k := app.StakingKeeper
+
+	rootChainID := hmTypes.GetRootChainID(hmTypes.RootChainTypeEth)
+	records := []stakingTypes.StakingRecord{
+		{Type: "validatorJoin", ValidatorID: 5, Nonce: 1, TxHash: hmTypes.ZeroHeimdallHash},
+		{Type: "stakeUpdate", ValidatorID: 2, Nonce: 7, TxHash: hmTypes.ZeroHeimdallHash},
+	}
+
+	// queue stored as single list
+	legacyKey := []byte{0x31, rootChainID}
+	store := ctx.KVStore(app.GetKey(stakingTypes.StoreKey))
+	store.Set(legacyKey, app.Codec().MustMarshalBinaryBare(records))
+
+	k.MigrateStakingQueues(ctx)
+	require.False(t, store.Has(legacyKey))
+
+	queue, err := k.GetStakingQueue(ctx, rootChainID)
+	require.NoError(t, err)
+	require.Equal(t, records, queue)
+
+	// no-op once migrated
+	k.MigrateStakingQueues(ctx)
+	queue, _ = k.GetStakingQueue(ctx, rootChainID)
+	require.Equal(t, records, queue)
+}
+
+func (suite *KeeperTestSuite) TestValidatorSetSnapshots() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	k := app.StakingKeeper
+
+	ctx = ctx.WithBlockHeight(10)
+	valSet := chSim.LoadValidatorSet(4, t, k, ctx, false, 10)
+
+	snapshot, found := k.GetValidatorSetSnapshotAtHeight(ctx, 10)
+	require.True(t, found)
+	require.Equal(t, int64(10), snapshot.Height)
+	require.Len(t, snapshot.Validators, 4)
+	require.Equal(t, valSet.TotalVotingPower(), snapshot.TotalVotingPower())
+
+	// no snapshot before first one
+	_, found = k.GetValidatorSetSnapshotAtHeight(ctx, 9)
+	require.False(t, found)
+
+	// proposer rotation doesn't change validators
+	k.IncrementAccum(ctx.WithBlockHeight(15), 1)
+	require.Len(t, k.GetValidatorSetSnapshots(ctx), 1)
+
+	// checkpoint 3 proposed at height 20, power change in same block
+	require.NoError(t, k.RecordCheckpointProposal(ctx.WithBlockHeight(20), hmTypes.RootChainTypeEth, 3))
+	valSet.Validators[0].VotingPower += 5
+	require.NoError(t, k.UpdateValidatorSetInStore(ctx.WithBlockHeight(20), valSet))
+	require.Len(t, k.GetValidatorSetSnapshots(ctx), 2)
+
+	snapshot, found = k.GetValidatorSetSnapshotAtHeight(ctx, 19)
+	require.True(t, found)
+	require.Equal(t, int64(10), snapshot.Height)
+
+	snapshot, found = k.GetValidatorSetSnapshotAtHeight(ctx, 100)
+	require.True(t, found)
+	require.Equal(t, int64(20), snapshot.Height)
+
+	// checkpoint 3 was signed by set in effect before its proposal block
+	snapshot, found = k.GetValidatorSetSnapshotAtCheckpoint(ctx, hmTypes.RootChainTypeEth, 3)
+	require.True(t, found)
+	require.Equal(t, int64(10), snapshot.Height)
+
+	// checkpoint 4 proposed on other root chain after power change
+	require.NoError(t, k.RecordCheckpointProposal(ctx.WithBlockHeight(25), hmTypes.RootChainTypeBsc, 4))
+	snapshot, found = k.GetValidatorSetSnapshotAtCheckpoint(ctx, hmTypes.RootChainTypeBsc, 4)
+	require.True(t, found)
+	require.Equal(t, int64(20), snapshot.Height)
+
+	_, found = k.GetValidatorSetSnapshotAtCheckpoint(ctx, hmTypes.RootChainTypeEth, 4)
+	require.False(t, found)
+}
+
+func (suite *KeeperTestSuite) TestPruneValidatorSetSnapshots() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	k := app.StakingKeeper
+
+	valSet := chSim.LoadValidatorSet(4, t, k, ctx.WithBlockHeight(1), false, 10)
+	for height := int64(2); height <= stakingTypes.MaxValidatorSetSnapshots+5; height++ {
+		valSet.Validators[0].VotingPower++
+		require.NoError(t, k.UpdateValidatorSetInStore(ctx.WithBlockHeight(height), valSet))
+	}
+
+	snapshots := k.GetValidatorSetSnapshots(ctx)
+	require.Len(t, snapshots, stakingTypes.MaxValidatorSetSnapshots)
+	require.Equal(t, int64(6), snapshots[0].Height)
+
+	for number := uint64(1); number <= stakingTypes.MaxCheckpointProposals+2; number++ {
+		require.NoError(t, k.RecordCheckpointProposal(ctx.WithBlockHeight(int64(number)), hmTypes.RootChainTypeEth, number))
+	}
+
+	require.Len(t, k.GetCheckpointProposals(ctx), stakingTypes.MaxCheckpointProposals)
+	_, found := k.GetCheckpointProposal(ctx, hmTypes.RootChainTypeEth, 2)
+	require.False(t, found)
+	_, found = k.GetCheckpointProposal(ctx, hmTypes.RootChainTypeEth, 3)
+	require.True(t, found)
+}
+
+func (suite *KeeperTestSuite) TestDelegations() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	delegator := hmTypes.BytesToHeimdallAddress([]byte("delegator-address-01"))
+	other := hmTypes.BytesToHeimdallAddress([]byte("delegator-address-02"))
+	tokens, _ := sdk.NewIntFromString("2000000000000000000")
+
+	// validator 2 and 10 must not share a prefix
+	_, err := keeper.AddDelegationShares(ctx, 2, delegator, sdk.NewInt(10), tokens, "1")
+	require.NoError(t, err)
+	_, err = keeper.AddDelegationShares(ctx, 10, delegator, sdk.NewInt(20), tokens, "2")
+	require.NoError(t, err)
+	_, err = keeper.AddDelegationShares(ctx, 2, other, sdk.NewInt(30), tokens, "3")
+	require.NoError(t, err)
+	delegation, err := keeper.AddDelegationShares(ctx, 2, delegator, sdk.NewInt(5), tokens, "4")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(15), delegation.Shares)
+	require.Equal(t, "4", delegation.LastUpdated)
+
+	delegations := keeper.GetDelegatorDelegations(ctx, delegator)
+	require.Len(t, delegations, 2)
+	require.Equal(t, hmTypes.ValidatorID(2), delegations[0].ValidatorID)
+	require.Equal(t, hmTypes.ValidatorID(10), delegations[1].ValidatorID)
+
+	validatorDelegations := stakingTypes.NewValidatorDelegations(2, keeper.GetValidatorDelegations(ctx, 2))
+	require.Len(t, validatorDelegations.Delegations, 2)
+	require.Equal(t, sdk.NewInt(45), validatorDelegations.TotalShares)
+	require.Equal(t, int64(6), validatorDelegations.TotalPower)
+	require.Len(t, keeper.GetAllDelegations(ctx), 3)
+
+	_, err = keeper.RemoveDelegationShares(ctx, 10, other, sdk.NewInt(1), tokens, "5")
+	require.Error(t, err)
+	_, err = keeper.RemoveDelegationShares(ctx, 10, delegator, sdk.NewInt(21), tokens, "5")
+	require.Error(t, err)
+	_, err = keeper.RemoveDelegationShares(ctx, 10, delegator, sdk.NewInt(20), tokens, "5")
+	require.NoError(t, err)
+
+	require.Len(t, keeper.GetDelegatorDelegations(ctx, delegator), 1)
+	require.Empty(t, keeper.GetValidatorDelegations(ctx, 10))
+}
+
+func (suite *KeeperTestSuite) TestValidatorPowers() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// capping
+	require.Equal(t, []int64{17, 10, 10, 10, 10}, stakingTypes.CapValidatorPowers([]int64{100, 10, 10, 10, 10}, 30))
+	require.Equal(t, []int64{10, 21, 21, 10}, stakingTypes.CapValidatorPowers([]int64{10, 50, 40, 10}, 34))
+	require.Equal(t, []int64{100, 10, 10, 10, 10}, stakingTypes.CapValidatorPowers([]int64{100, 10, 10, 10, 10}, 0))
+	require.Equal(t, []int64{100, 10, 10, 10, 10}, stakingTypes.CapValidatorPowers([]int64{100, 10, 10, 10, 10}, 100))
+	require.Equal(t, []int64{100, 10, 10}, stakingTypes.CapValidatorPowers([]int64{100, 10, 10}, 30), "cap can't be met by 3 validators")
+	require.Equal(t, []int64{25, 25, 25, 25}, stakingTypes.CapValidatorPowers([]int64{25, 25, 25, 25}, 25))
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validatorSet := keeper.GetValidatorSet(ctx)
+
+	var totalPower int64
+	for _, validator := range validatorSet.Validators {
+		totalPower += validator.VotingPower
+	}
+
+	// disabled by default, effective power is raw power
+	require.Equal(t, uint64(0), keeper.GetParams(ctx).MaxValidatorPowerPercent)
+
+	powers := keeper.GetValidatorPowers(ctx)
+	require.Equal(t, totalPower, powers.TotalPower)
+	require.Equal(t, totalPower, powers.TotalEffectivePower)
+	require.Len(t, powers.Validators, len(validatorSet.Validators))
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidatorPowerPercent = 26
+	keeper.SetParams(ctx, params)
+	require.Equal(t, uint64(26), keeper.GetParams(ctx).MaxValidatorPowerPercent)
+
+	powers = keeper.GetValidatorPowers(ctx)
+	require.Equal(t, totalPower, powers.TotalPower, "raw power is kept")
+	for i, validator := range powers.Validators {
+		require.Equal(t, validatorSet.Validators[i].ID, validator.ID)
+		require.Equal(t, validatorSet.Validators[i].VotingPower, validator.Power)
+		require.LessOrEqual(t, validator.EffectivePower, validator.Power)
+		require.LessOrEqual(t, validator.EffectivePower*100, powers.TotalEffectivePower*26)
+	}
+
+	require.Error(t, stakingTypes.Params{StakingBufferTime: time.Minute, MaxValidatorPowerPercent: 101}.Validate())
+}
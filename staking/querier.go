@@ -38,6 +38,18 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryNextStaking(ctx, req, keeper)
 		case types.QueryStakingQueue:
 			return handleQueryStakingQueue(ctx, req, keeper)
+		case types.QueryValidatorSetAtHeight:
+			return handleQueryValidatorSetAtHeight(ctx, req, keeper)
+		case types.QueryValidatorSetAtCheckpoint:
+			return handleQueryValidatorSetAtCheckpoint(ctx, req, keeper)
+		case types.QueryDelegation:
+			return handleQueryDelegation(ctx, req, keeper)
+		case types.QueryDelegatorDelegations:
+			return handleQueryDelegatorDelegations(ctx, req, keeper)
+		case types.QueryValidatorDelegations:
+			return handleQueryValidatorDelegations(ctx, req, keeper)
+		case types.QueryValidatorPowers:
+			return handleQueryValidatorPowers(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -56,7 +68,16 @@ func handleQueryTotalValidatorPower(ctx sdk.Context, req abci.RequestQuery, keep
 
 func handleQueryCurrentValidatorSet(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// get validator set
-	validatorSet := keeper.GetValidatorSet(ctx)
+	validatorSet := types.ValidatorSetWithMetadata{
+		ValidatorSet: keeper.GetValidatorSet(ctx),
+		Metadata:     make(map[hmTypes.ValidatorID]types.ValidatorMetadata),
+	}
+
+	for _, validator := range validatorSet.Validators {
+		if metadata, found := keeper.GetValidatorMetadata(ctx, validator.ID); found {
+			validatorSet.Metadata[validator.ID] = metadata
+		}
+	}
 
 	// json record
 	bz, err := json.Marshal(validatorSet)
@@ -93,11 +114,16 @@ func handleQueryValidator(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	}
 
 	// get validator info
-	validator, ok := keeper.GetValidatorFromValID(ctx, params.ValidatorID)
+	validatorInfo, ok := keeper.GetValidatorFromValID(ctx, params.ValidatorID)
 	if !ok {
 		return nil, sdk.ErrUnknownRequest("No validator found")
 	}
 
+	validator := types.ValidatorWithMetadata{Validator: validatorInfo}
+	if metadata, found := keeper.GetValidatorMetadata(ctx, params.ValidatorID); found {
+		validator.Metadata = &metadata
+	}
+
 	// json record
 	bz, err := json.Marshal(validator)
 	if err != nil {
@@ -201,7 +227,7 @@ func handleQueryNextStaking(ctx sdk.Context, req abci.RequestQuery, keeper Keepe
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetNextStakingRecordFromQueue(ctx, hmTypes.GetRootChainID(params.RootChain))
+	res, err := keeper.GetNextStakingRecordFromQueue(ctx, keeper.chainKeeper.GetRootChainID(ctx, params.RootChain))
 
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch next staking record", err.Error()))
@@ -224,7 +250,7 @@ func handleQueryStakingQueue(ctx sdk.Context, req abci.RequestQuery, keeper Keep
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetStakingQueue(ctx, hmTypes.GetRootChainID(params.RootChain))
+	res, err := keeper.GetStakingQueue(ctx, keeper.chainKeeper.GetRootChainID(ctx, params.RootChain))
 
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch next staking record", err.Error()))
@@ -240,3 +266,102 @@ func handleQueryStakingQueue(ctx sdk.Context, req abci.RequestQuery, keeper Keep
 	}
 	return bz, nil
 }
+
+func handleQueryValidatorSetAtHeight(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSetAtHeightParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	snapshot, found := keeper.GetValidatorSetSnapshotAtHeight(ctx, params.Height)
+	if !found {
+		return nil, sdk.ErrInternal(fmt.Sprintf("no validator set snapshot at height %v", params.Height))
+	}
+
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetAtCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStakingParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	snapshot, found := keeper.GetValidatorSetSnapshotAtCheckpoint(ctx, params.RootChain, params.Number)
+	if !found {
+		return nil, sdk.ErrInternal(fmt.Sprintf("no validator set snapshot for checkpoint %v of %v", params.Number, params.RootChain))
+	}
+
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryDelegation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	delegation, found := keeper.GetDelegation(ctx, params.ValidatorID, params.DelegatorAddress)
+	if !found {
+		return nil, sdk.ErrInternal(fmt.Sprintf("no delegation of %v on validator %v", params.DelegatorAddress, params.ValidatorID))
+	}
+
+	bz, err := json.Marshal(types.NewDelegationResponse(delegation))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	delegations := keeper.GetDelegatorDelegations(ctx, params.DelegatorAddress)
+
+	result := make([]types.DelegationResponse, 0, len(delegations))
+	for _, delegation := range delegations {
+		result = append(result, types.NewDelegationResponse(delegation))
+	}
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if _, ok := keeper.GetValidatorFromValID(ctx, params.ValidatorID); !ok {
+		return nil, sdk.ErrUnknownRequest("No validator found")
+	}
+
+	bz, err := json.Marshal(types.NewValidatorDelegations(params.ValidatorID, keeper.GetValidatorDelegations(ctx, params.ValidatorID)))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorPowers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetValidatorPowers(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -229,3 +230,73 @@ func (suite *QuerierTestSuite) TestHandleQueryStakingSequence() {
 	require.NotNil(t, res)
 	require.Equal(t, sequence.String(), string(res))
 }
+
+func (suite *QuerierTestSuite) TestHandleQueryValidatorSetAtHeight() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx.WithBlockHeight(5), false, 10)
+
+	path := []string{types.QueryValidatorSetAtHeight}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetAtHeight)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryValidatorSetAtHeightParams(8)),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var snapshot types.ValidatorSetSnapshot
+	require.NoError(t, json.Unmarshal(res, &snapshot))
+	require.Equal(t, int64(5), snapshot.Height)
+	require.Len(t, snapshot.Validators, 4)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryValidatorSetAtHeightParams(4))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+
+	require.NoError(t, keeper.RecordCheckpointProposal(ctx.WithBlockHeight(8), hmTypes.RootChainTypeEth, 1))
+
+	path = []string{types.QueryValidatorSetAtCheckpoint}
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetAtCheckpoint),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryStakingParams(1, hmTypes.RootChainTypeEth)),
+	}
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &snapshot))
+	require.Equal(t, int64(5), snapshot.Height)
+}
+
+func (suite *QuerierTestSuite) TestHandleQueryValidatorMetadata() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.StakingKeeper
+	valSet := chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	metadata := types.NewValidatorMetadata(validator.ID, "moniker", "https://example.com", "", "")
+	require.NoError(t, keeper.SetValidatorMetadata(ctx, metadata))
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidator),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryValidatorParams(validator.ID)),
+	}
+	res, err := querier(ctx, []string{types.QueryValidator}, req)
+	require.NoError(t, err)
+
+	var validatorWithMetadata types.ValidatorWithMetadata
+	require.NoError(t, json.Unmarshal(res, &validatorWithMetadata))
+	require.Equal(t, validator.Signer, validatorWithMetadata.Signer)
+	require.Equal(t, &metadata, validatorWithMetadata.Metadata)
+
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentValidatorSet),
+	}
+	res, err = querier(ctx, []string{types.QueryCurrentValidatorSet}, req)
+	require.NoError(t, err)
+
+	var validatorSet types.ValidatorSetWithMetadata
+	require.NoError(t, json.Unmarshal(res, &validatorSet))
+	require.Len(t, validatorSet.Validators, 4)
+	require.Equal(t, map[hmTypes.ValidatorID]types.ValidatorMetadata{validator.ID: metadata}, validatorSet.Metadata)
+}
@@ -36,6 +36,10 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 			return SideHandleMsgStakingSync(ctx, msg, k, contractCaller)
 		case types.MsgStakingSyncAck:
 			return SideHandleMsgStakingSyncAck(ctx, msg, k, contractCaller)
+		case types.MsgDelegate:
+			return SideHandleMsgDelegate(ctx, msg, k, contractCaller)
+		case types.MsgUndelegate:
+			return SideHandleMsgUndelegate(ctx, msg, k, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -62,6 +66,10 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 			return PostHandleMsgStakingSync(ctx, k, msg, sideTxResult)
 		case types.MsgStakingSyncAck:
 			return PostHandleMsgStakingSyncAck(ctx, k, msg, sideTxResult)
+		case types.MsgDelegate:
+			return PostHandleMsgDelegate(ctx, k, msg, sideTxResult)
+		case types.MsgUndelegate:
+			return PostHandleMsgUndelegate(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unrecognized Staking Msg type").Result()
 		}
@@ -77,23 +85,13 @@ func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k K
 		"blockNumber", msg.BlockNumber,
 	)
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
-
-	var (
-		contractAddress ethCommon.Address
-		receipt         *ethTypes.Receipt
-		err             error
-	)
-	// get event log on tron
-	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	// get event log on stake chain
+	receipt, adapter, contracts, err := getStakeChainReceipt(ctx, k, contractCaller, msg.TxHash)
 	if err != nil || receipt == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 	}
-	contractAddress = hmTypes.HexToTronAddress(chainParams.TronStakingInfoAddress)
 	// decode validator join event
-	eventLog, err := contractCaller.DecodeValidatorJoinEvent(contractAddress, receipt, msg.LogIndex)
+	eventLog, err := adapter.DecodeValidatorJoinEvent(receipt, msg.LogIndex, contracts)
 	if err != nil || eventLog == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
 	}
@@ -216,26 +214,16 @@ func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Kee
 		"blockNumber", msg.BlockNumber,
 	)
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
-
-	var (
-		contractAddress ethCommon.Address
-		receipt         *ethTypes.Receipt
-		err             error
-	)
-	// get event log on tron
-	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	// get event log on stake chain
+	receipt, adapter, contracts, err := getStakeChainReceipt(ctx, k, contractCaller, msg.TxHash)
 	if err != nil || receipt == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 	}
-	contractAddress = hmTypes.HexToTronAddress(chainParams.TronStakingInfoAddress)
 
 	newPubKey := msg.NewSignerPubKey
 	newSigner := newPubKey.Address()
 
-	eventLog, err := contractCaller.DecodeSignerUpdateEvent(contractAddress, receipt, msg.LogIndex)
+	eventLog, err := adapter.DecodeSignerUpdateEvent(receipt, msg.LogIndex, contracts)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -281,24 +269,14 @@ func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k K
 		"blockNumber", msg.BlockNumber,
 	)
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
-
-	var (
-		contractAddress ethCommon.Address
-		receipt         *ethTypes.Receipt
-		err             error
-	)
-	// get event log on tron
-	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	// get event log on stake chain
+	receipt, adapter, contracts, err := getStakeChainReceipt(ctx, k, contractCaller, msg.TxHash)
 	if err != nil || receipt == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 	}
-	contractAddress = hmTypes.HexToTronAddress(chainParams.TronStakingInfoAddress)
 
 	// decode validator exit
-	eventLog, err := contractCaller.DecodeValidatorExitEvent(contractAddress, receipt, msg.LogIndex)
+	eventLog, err := adapter.DecodeValidatorExitEvent(receipt, msg.LogIndex, contracts)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -339,28 +317,10 @@ func SideHandleMsgStakingSync(ctx sdk.Context, msg types.MsgStakingSync, k Keepe
 		"root", msg.RootChain,
 	)
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
-
-	var nonce uint64
-	switch msg.RootChain {
-	case hmTypes.RootChainTypeEth:
-		stakingManagerAddress := chainParams.StakingManagerAddress.EthAddress()
-		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.chainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
-		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChain, " does not  match bsc")
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
-		}
-		stakingManagerAddress := bscChain.StakingManagerAddress.EthAddress()
-		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
-	case hmTypes.RootChainTypeTron:
-		stakingManagerAddress := chainParams.TronStakingManagerAddress
-		nonce = contractCaller.GetTronStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerAddress)
+	nonce, err := getStakingSyncNonce(ctx, k, msg.ValidatorID.Uint64(), msg.RootChain, contractCaller)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch nonce from root", "root", msg.RootChain, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
 	if nonce >= msg.Nonce {
 		k.Logger(ctx).Error("Nonce in message is not match with nonce in root", "msgNonce",
@@ -382,28 +342,10 @@ func SideHandleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k
 		"root", msg.RootChain,
 	)
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
-
-	var nonce uint64
-	switch msg.RootChain {
-	case hmTypes.RootChainTypeEth:
-		stakingManagerAddress := chainParams.StakingManagerAddress.EthAddress()
-		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.chainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
-		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChain, "does not match bsc")
-			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
-		}
-		stakingManagerAddress := bscChain.StakingManagerAddress.EthAddress()
-		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
-	case hmTypes.RootChainTypeTron:
-		stakingManagerAddress := chainParams.TronStakingManagerAddress
-		nonce = contractCaller.GetTronStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerAddress)
+	nonce, err := getStakingSyncNonce(ctx, k, msg.ValidatorID.Uint64(), msg.RootChain, contractCaller)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch nonce from root", "root", msg.RootChain, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 	}
 	if nonce < msg.Nonce {
 		k.Logger(ctx).Error("Nonce in message is bigger than nonce in root", "msgNonce", msg.Nonce, "nonceFromRoot", nonce)
@@ -415,6 +357,21 @@ func SideHandleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k
 	return
 }
 
+// getStakingSyncNonce returns validator nonce from stake manager on root chain
+func getStakingSyncNonce(ctx sdk.Context, k Keeper, validatorID uint64, rootChain string, contractCaller helper.IContractCaller) (uint64, error) {
+	adapter, err := helper.GetRootChainAdapter(contractCaller, rootChain)
+	if err != nil {
+		return 0, err
+	}
+
+	contracts, err := k.chainKeeper.GetRootChainContracts(ctx, rootChain)
+	if err != nil {
+		return 0, err
+	}
+
+	return adapter.GetStakingSyncNonce(validatorID, contracts), nil
+}
+
 /*
 	Post Handlers - update the state of the tx
 **/
@@ -500,9 +457,9 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 		),
 	})
 
-	// save staking record
-	for root, rootID := range hmTypes.GetRootChainIDMap() {
-		if root != hmTypes.RootChainTypeStake {
+	// save staking record, bridge holds records of paused chains back until chain is active again
+	for root, rootID := range k.chainKeeper.GetRootChainIDMap(ctx) {
+		if root != hmTypes.RootChainTypeStake && !k.chainKeeper.IsChainRetired(ctx, root) {
 			k.AddStakingRecordToQueue(ctx, rootID, types.StakingRecord{
 				Type:        "validatorJoin",
 				ValidatorID: msg.ID,
@@ -715,9 +672,9 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 		),
 	})
 
-	// save staking record
-	for root, rootID := range hmTypes.GetRootChainIDMap() {
-		if root != hmTypes.RootChainTypeStake {
+	// save staking record, bridge holds records of paused chains back until chain is active again
+	for root, rootID := range k.chainKeeper.GetRootChainIDMap(ctx) {
+		if root != hmTypes.RootChainTypeStake && !k.chainKeeper.IsChainRetired(ctx, root) {
 			k.AddStakingRecordToQueue(ctx, rootID, types.StakingRecord{
 				Type:        "signerUpdate",
 				ValidatorID: msg.ID,
@@ -794,9 +751,9 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k Keeper, msg types.MsgValidato
 		),
 	})
 
-	// save staking record
-	for root, rootID := range hmTypes.GetRootChainIDMap() {
-		if root != hmTypes.RootChainTypeStake {
+	// save staking record, bridge holds records of paused chains back until chain is active again
+	for root, rootID := range k.chainKeeper.GetRootChainIDMap(ctx) {
+		if root != hmTypes.RootChainTypeStake && !k.chainKeeper.IsChainRetired(ctx, root) {
 			k.AddStakingRecordToQueue(ctx, rootID, types.StakingRecord{
 				Type:        "validatorExit",
 				ValidatorID: msg.ID,
@@ -824,7 +781,7 @@ func PostHandleMsgStakingSyncAck(ctx sdk.Context, k Keeper, msg types.MsgStaking
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
-	rootChainID := hmTypes.GetRootChainID(msg.RootChain)
+	rootChainID := k.chainKeeper.GetRootChainID(ctx, msg.RootChain)
 	// get last staking from buffer
 	stakingRecord, err := k.GetNextStakingRecordFromQueue(ctx, rootChainID)
 	if err != nil || stakingRecord == nil {
@@ -880,7 +837,7 @@ func PostHandleMsgStakingSync(ctx sdk.Context, k Keeper, msg types.MsgStakingSyn
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
-	rootChainID := hmTypes.GetRootChainID(msg.RootChain)
+	rootChainID := k.chainKeeper.GetRootChainID(ctx, msg.RootChain)
 	// get last staking from buffer
 	stakingRecord, err := k.GetNextStakingRecordFromQueue(ctx, rootChainID)
 	if err != nil || stakingRecord == nil {
@@ -945,3 +902,252 @@ func PostHandleMsgStakingSync(ctx sdk.Context, k Keeper, msg types.MsgStakingSyn
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// SideHandleMsgDelegate handles delegate message, verifies ShareMinted event on stake chain
+func SideHandleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for delegate msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// get event log on stake chain
+	receipt, adapter, contracts, err := getStakeChainReceipt(ctx, k, contractCaller, msg.TxHash)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	eventLog, err := adapter.DecodeShareMintedEvent(receipt, msg.LogIndex, contracts)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !validateDelegationEvent(ctx, k, msg.ID, msg.Delegator, msg.Shares, msg.Amount, eventLog.ValidatorId, eventLog.User, eventLog.Amount, eventLog.Tokens) {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for delegate msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgUndelegate handles undelegate message, verifies ShareBurned event on stake chain
+func SideHandleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k Keeper, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for undelegate msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// get event log on stake chain
+	receipt, adapter, contracts, err := getStakeChainReceipt(ctx, k, contractCaller, msg.TxHash)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
+	}
+
+	eventLog, err := adapter.DecodeShareBurnedEvent(receipt, msg.LogIndex, contracts)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !validateDelegationEvent(ctx, k, msg.ID, msg.Delegator, msg.Shares, msg.Amount, eventLog.ValidatorId, eventLog.User, eventLog.Amount, eventLog.Tokens) {
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for undelegate msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// getStakeChainReceipt returns receipt of tx on stake chain along with adapter and contracts to decode its events
+func getStakeChainReceipt(ctx sdk.Context, k Keeper, contractCaller helper.IContractCaller, txHash hmTypes.HeimdallHash) (*ethTypes.Receipt, helper.RootChainAdapter, helper.RootChainContracts, error) {
+	adapter, err := helper.GetRootChainAdapter(contractCaller, hmTypes.RootChainTypeStake)
+	if err != nil {
+		return nil, nil, helper.RootChainContracts{}, err
+	}
+
+	contracts, err := k.chainKeeper.GetRootChainContracts(ctx, hmTypes.RootChainTypeStake)
+	if err != nil {
+		return nil, nil, helper.RootChainContracts{}, err
+	}
+
+	txConfirmations, err := k.chainKeeper.GetTxConfirmations(ctx, hmTypes.RootChainTypeStake)
+	if err != nil {
+		return nil, nil, helper.RootChainContracts{}, err
+	}
+
+	receipt, err := adapter.GetConfirmedTxReceipt(txHash, txConfirmations, false)
+	if err != nil {
+		return nil, nil, helper.RootChainContracts{}, err
+	}
+
+	return receipt, adapter, contracts, nil
+}
+
+// validateDelegationEvent checks delegation msg against share event fields
+func validateDelegationEvent(ctx sdk.Context, k Keeper, id hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, shares, amount sdk.Int, eventID *big.Int, eventUser ethCommon.Address, eventShares, eventTokens *big.Int) bool {
+	if eventID.Uint64() != id.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", id, "validatorIdFromTx", eventID)
+		return false
+	}
+
+	if !bytes.Equal(eventUser.Bytes(), delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesn't match with user in log", "msgDelegator", delegator.String(), "userFromTx", eventUser.Hex())
+		return false
+	}
+
+	if eventShares.Cmp(shares.BigInt()) != 0 {
+		k.Logger(ctx).Error("Shares in message doesn't match with shares in log", "msgShares", shares, "sharesFromTx", eventShares)
+		return false
+	}
+
+	if eventTokens.Cmp(amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesn't match with tokens in log", "msgAmount", amount, "tokensFromTx", eventTokens)
+		return false
+	}
+
+	return true
+}
+
+// PostHandleMsgDelegate adds minted shares to delegation
+func PostHandleMsgDelegate(ctx sdk.Context, k Keeper, msg types.MsgDelegate, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if delegate is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping delegate since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// Check for replay attack
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Debug("Persisting delegate", "sideTxResult", sideTxResult)
+
+	delegation, err := k.AddDelegationShares(ctx, msg.ID, msg.Delegator, msg.Shares, msg.Amount, sequence.String())
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update delegation", "error", err, "validatorID", msg.ID, "delegator", msg.Delegator)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, delegation.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, delegation.Amount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgUndelegate removes burned shares from delegation
+func PostHandleMsgUndelegate(ctx sdk.Context, k Keeper, msg types.MsgUndelegate, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if undelegate is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping undelegate since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// Check for replay attack
+	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
+	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// delegation made before delegations were tracked, nothing to remove
+	if _, found := k.GetDelegation(ctx, msg.ID, msg.Delegator); !found {
+		k.Logger(ctx).Info("Undelegate of untracked delegation", "validatorID", msg.ID, "delegator", msg.Delegator, "shares", msg.Shares)
+
+		k.SetStakingSequence(ctx, sequence.String())
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUndelegateUntracked,
+				sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+				sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+				sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+				sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+				sdk.NewAttribute(types.AttributeKeyShares, msg.Shares.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			),
+		})
+
+		return sdk.Result{
+			Events: ctx.EventManager().Events(),
+		}
+	}
+
+	k.Logger(ctx).Debug("Persisting undelegate", "sideTxResult", sideTxResult)
+
+	delegation, err := k.RemoveDelegationShares(ctx, msg.ID, msg.Delegator, msg.Shares, msg.Amount, sequence.String())
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update delegation", "error", err, "validatorID", msg.ID, "delegator", msg.Delegator)
+		return hmCommon.ErrInsufficientShares(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegate,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, delegation.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, delegation.Amount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
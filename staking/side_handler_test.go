@@ -1374,3 +1374,188 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgValidatorExit() {
 //		require.Equal(t, acctualPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newAmount.Uint64())
 //	})
 //}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgDelegate() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	validators := keeper.GetCurrentValidators(ctx)
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	contractAddress := hmTypes.HexToTronAddress(chainParams.ChainParams.TronStakingInfoAddress)
+	logIndex := uint64(0)
+	blockNumber := big.NewInt(10)
+	delegator := hmTypes.BytesToHeimdallAddress([]byte("delegator-address-01"))
+	shares, _ := big.NewInt(0).SetString("5000000000000000000", 10)
+	tokens, _ := big.NewInt(0).SetString("10000000000000000000", 10)
+
+	msg := types.NewMsgDelegate(
+		validators[0].Signer,
+		uint64(validators[0].ID),
+		delegator,
+		sdk.NewIntFromBigInt(shares),
+		sdk.NewIntFromBigInt(tokens),
+		msgTxHash,
+		logIndex,
+		blockNumber.Uint64(),
+	)
+
+	suite.Run("Success", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		txreceipt := &ethTypes.Receipt{
+			BlockNumber: blockNumber,
+		}
+
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.Hex()).Return(txreceipt, nil)
+		suite.contractCaller.On("DecodeShareMintedEvent", contractAddress, txreceipt, logIndex).Return(&stakinginfo.StakinginfoShareMinted{
+			ValidatorId: big.NewInt(0).SetUint64(validators[0].ID.Uint64()),
+			User:        delegator.EthAddress(),
+			Amount:      shares,
+			Tokens:      tokens,
+		}, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Delegator mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		txreceipt := &ethTypes.Receipt{
+			BlockNumber: blockNumber,
+		}
+
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.Hex()).Return(txreceipt, nil)
+		suite.contractCaller.On("DecodeShareMintedEvent", contractAddress, txreceipt, logIndex).Return(&stakinginfo.StakinginfoShareMinted{
+			ValidatorId: big.NewInt(0).SetUint64(validators[0].ID.Uint64()),
+			User:        validators[1].Signer.EthAddress(),
+			Amount:      shares,
+			Tokens:      tokens,
+		}, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Success with root chain adapter", func() {
+		adapter := &mocks.RootChainAdapter{}
+		helper.RegisterRootChainAdapter(hmTypes.RootChainTypeStake, func(helper.IContractCaller, string) helper.RootChainAdapter {
+			return adapter
+		})
+		defer helper.RegisterRootChainAdapter(hmTypes.RootChainTypeStake, helper.NewTronRootChainAdapter)
+
+		txreceipt := &ethTypes.Receipt{
+			BlockNumber: blockNumber,
+		}
+		contracts, err := app.ChainKeeper.GetRootChainContracts(ctx, hmTypes.RootChainTypeStake)
+		require.NoError(t, err)
+		txConfirmations, err := app.ChainKeeper.GetTxConfirmations(ctx, hmTypes.RootChainTypeStake)
+		require.NoError(t, err)
+
+		adapter.On("GetConfirmedTxReceipt", msgTxHash, txConfirmations, false).Return(txreceipt, nil)
+		adapter.On("DecodeShareMintedEvent", txreceipt, logIndex, contracts).Return(&stakinginfo.StakinginfoShareMinted{
+			ValidatorId: big.NewInt(0).SetUint64(validators[0].ID.Uint64()),
+			User:        delegator.EthAddress(),
+			Amount:      shares,
+			Tokens:      tokens,
+		}, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+		adapter.AssertExpectations(t)
+	})
+
+	suite.Run("No Eventlog", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		txreceipt := &ethTypes.Receipt{
+			BlockNumber: blockNumber,
+		}
+
+		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.Hex()).Return(txreceipt, nil)
+		suite.contractCaller.On("DecodeShareMintedEvent", contractAddress, txreceipt, logIndex).Return(nil, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgDelegation() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	validators := keeper.GetCurrentValidators(ctx)
+	msgTxHash := hmTypes.HexToHeimdallHash("123")
+	delegator := hmTypes.BytesToHeimdallAddress([]byte("delegator-address-01"))
+	tokens, _ := sdk.NewIntFromString("10000000000000000000")
+
+	delegate := types.NewMsgDelegate(validators[0].Signer, uint64(validators[0].ID), delegator, sdk.NewInt(100), tokens, msgTxHash, 0, 10)
+
+	suite.Run("No Success", func() {
+		result := suite.postHandler(ctx, delegate, abci.SideTxResultType_No)
+		require.False(t, result.IsOK(), errs.CodeToDefaultMsg(result.Code))
+
+		_, found := keeper.GetDelegation(ctx, validators[0].ID, delegator)
+		require.False(t, found)
+	})
+
+	suite.Run("Delegate", func() {
+		result := suite.postHandler(ctx, delegate, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+
+		delegation, found := keeper.GetDelegation(ctx, validators[0].ID, delegator)
+		require.True(t, found)
+		require.Equal(t, sdk.NewInt(100), delegation.Shares)
+		require.Equal(t, int64(10), delegation.Power())
+
+		// replay
+		result = suite.postHandler(ctx, delegate, abci.SideTxResultType_Yes)
+		require.Equal(t, errs.CodeOldTx, result.Code)
+	})
+
+	suite.Run("Undelegate", func() {
+		halfTokens, _ := sdk.NewIntFromString("5000000000000000000")
+		undelegate := types.NewMsgUndelegate(validators[0].Signer, uint64(validators[0].ID), delegator, sdk.NewInt(50), halfTokens, msgTxHash, 1, 10)
+
+		result := suite.postHandler(ctx, undelegate, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+
+		delegation, found := keeper.GetDelegation(ctx, validators[0].ID, delegator)
+		require.True(t, found)
+		require.Equal(t, sdk.NewInt(50), delegation.Shares)
+		require.Equal(t, int64(5), delegation.Power())
+
+		// burning more shares than delegated
+		undelegate = types.NewMsgUndelegate(validators[0].Signer, uint64(validators[0].ID), delegator, sdk.NewInt(51), halfTokens, msgTxHash, 2, 10)
+		result = suite.postHandler(ctx, undelegate, abci.SideTxResultType_Yes)
+		require.Equal(t, errs.CodeInsufficientShares, result.Code)
+
+		// burning all shares removes delegation
+		undelegate = types.NewMsgUndelegate(validators[0].Signer, uint64(validators[0].ID), delegator, sdk.NewInt(50), halfTokens, msgTxHash, 3, 10)
+		result = suite.postHandler(ctx, undelegate, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+
+		_, found = keeper.GetDelegation(ctx, validators[0].ID, delegator)
+		require.False(t, found)
+		require.Empty(t, keeper.GetDelegatorDelegations(ctx, delegator))
+	})
+
+	suite.Run("Untracked", func() {
+		// shares minted before delegations were tracked
+		untracked := hmTypes.BytesToHeimdallAddress([]byte("delegator-address-02"))
+		undelegate := types.NewMsgUndelegate(validators[0].Signer, uint64(validators[0].ID), untracked, sdk.NewInt(50), tokens, msgTxHash, 4, 10)
+
+		result := suite.postHandler(ctx, undelegate, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+		require.Equal(t, types.EventTypeUndelegateUntracked, result.Events[len(result.Events)-1].Type)
+
+		_, found := keeper.GetDelegation(ctx, validators[0].ID, untracked)
+		require.False(t, found)
+
+		// replay
+		result = suite.postHandler(ctx, undelegate, abci.SideTxResultType_Yes)
+		require.Equal(t, errs.CodeOldTx, result.Code)
+	})
+}
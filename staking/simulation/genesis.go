@@ -42,6 +42,6 @@ func RandomizedGenState(simState *module.SimulationState) {
 	param := types.Params{
 		StakingBufferTime: time.Duration(simulation.RandIntBetween(r1, 1, 10)) * time.Minute,
 	}
-	genesisState := types.NewGenesisState(param, validators, *validatorSet, stakingSequence)
+	genesisState := types.NewGenesisState(param, validators, *validatorSet, stakingSequence, nil, nil, nil, nil, nil)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 }
@@ -13,6 +13,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgStakeUpdate{}, "staking/MsgStakeUpdate", nil)
 	cdc.RegisterConcrete(MsgStakingSync{}, "staking/MsgStakingSync", nil)
 	cdc.RegisterConcrete(MsgStakingSyncAck{}, "staking/MsgStakingSyncAck", nil)
+	cdc.RegisterConcrete(MsgEditValidatorMetadata{}, "staking/MsgEditValidatorMetadata", nil)
+	cdc.RegisterConcrete(MsgDelegate{}, "staking/MsgDelegate", nil)
+	cdc.RegisterConcrete(MsgUndelegate{}, "staking/MsgUndelegate", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
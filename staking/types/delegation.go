@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Delegation delegator shares of validator mirrored from stake chain share events
+type Delegation struct {
+	DelegatorAddress hmTypes.HeimdallAddress `json:"delegator_address"`
+	ValidatorID      hmTypes.ValidatorID     `json:"validator_id"`
+	Shares           sdk.Int                 `json:"shares"`
+	Amount           sdk.Int                 `json:"amount"`
+	LastUpdated      string                  `json:"last_updated"`
+}
+
+// NewDelegation creates new delegation
+func NewDelegation(delegator hmTypes.HeimdallAddress, id hmTypes.ValidatorID, shares, amount sdk.Int, lastUpdated string) Delegation {
+	return Delegation{
+		DelegatorAddress: delegator,
+		ValidatorID:      id,
+		Shares:           shares,
+		Amount:           amount,
+		LastUpdated:      lastUpdated,
+	}
+}
+
+// Power returns power delegated tokens contribute to validator
+func (d Delegation) Power() int64 {
+	return GetPowerFromDelegatedAmount(d.Amount)
+}
+
+// Validate checks delegation fields
+func (d Delegation) Validate() error {
+	if d.DelegatorAddress.Empty() {
+		return fmt.Errorf("empty delegator address")
+	}
+
+	if d.ValidatorID == 0 {
+		return fmt.Errorf("invalid validator id")
+	}
+
+	if d.Shares == (sdk.Int{}) || !d.Shares.IsPositive() {
+		return fmt.Errorf("invalid shares of delegator %v on validator %v", d.DelegatorAddress, d.ValidatorID)
+	}
+
+	if d.Amount == (sdk.Int{}) || d.Amount.IsNegative() {
+		return fmt.Errorf("invalid amount of delegator %v on validator %v", d.DelegatorAddress, d.ValidatorID)
+	}
+
+	return nil
+}
+
+// String returns human readable delegation
+func (d Delegation) String() string {
+	return fmt.Sprintf("Delegation{%v -> %v shares: %v amount: %v}", d.DelegatorAddress.String(), d.ValidatorID, d.Shares, d.Amount)
+}
+
+// DelegationResponse delegation with its power contribution
+type DelegationResponse struct {
+	Delegation
+	Power int64 `json:"power"`
+}
+
+// NewDelegationResponse creates delegation response
+func NewDelegationResponse(delegation Delegation) DelegationResponse {
+	return DelegationResponse{
+		Delegation: delegation,
+		Power:      delegation.Power(),
+	}
+}
+
+// ValidatorDelegations delegator list of validator with totals
+type ValidatorDelegations struct {
+	ValidatorID hmTypes.ValidatorID  `json:"validator_id"`
+	TotalShares sdk.Int              `json:"total_shares"`
+	TotalAmount sdk.Int              `json:"total_amount"`
+	TotalPower  int64                `json:"total_power"`
+	Delegations []DelegationResponse `json:"delegations"`
+}
+
+// NewValidatorDelegations creates delegator list of validator and sums it up
+func NewValidatorDelegations(id hmTypes.ValidatorID, delegations []Delegation) ValidatorDelegations {
+	result := ValidatorDelegations{
+		ValidatorID: id,
+		TotalShares: sdk.ZeroInt(),
+		TotalAmount: sdk.ZeroInt(),
+		Delegations: make([]DelegationResponse, 0, len(delegations)),
+	}
+
+	for _, delegation := range delegations {
+		result.TotalShares = result.TotalShares.Add(delegation.Shares)
+		result.TotalAmount = result.TotalAmount.Add(delegation.Amount)
+		result.Delegations = append(result.Delegations, NewDelegationResponse(delegation))
+	}
+
+	result.TotalPower = GetPowerFromDelegatedAmount(result.TotalAmount)
+
+	return result
+}
+
+// GetPowerFromDelegatedAmount returns power of delegated amount, zero below one token
+func GetPowerFromDelegatedAmount(amount sdk.Int) int64 {
+	if amount == (sdk.Int{}) {
+		return 0
+	}
+
+	power, err := helper.GetPowerFromAmount(amount.BigInt())
+	if err != nil || !power.IsInt64() {
+		return 0
+	}
+
+	return power.Int64()
+}
@@ -10,6 +10,11 @@ var (
 	EventTypeStakingSync    = "staking-sync"
 	EventTypeStakingSyncAck = "staking-ack"
 
+	EventTypeEditValidatorMetadata = "edit-validator-metadata"
+	EventTypeDelegate              = "delegate"
+	EventTypeUndelegate            = "undelegate"
+	EventTypeUndelegateUntracked   = "undelegate-untracked"
+
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
@@ -17,6 +22,10 @@ var (
 	AttributeKeyValidatorNonce    = "validator-nonce"
 	AttributeKeyUpdatedAt         = "updated-at"
 	AttributeKeyRootChain         = "root-chain"
+	AttributeKeyMoniker           = "moniker"
+	AttributeKeyDelegator         = "delegator"
+	AttributeKeyShares            = "shares"
+	AttributeKeyAmount            = "amount"
 
 	AttributeValueCategory = ModuleName
 )
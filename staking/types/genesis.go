@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -32,12 +33,24 @@ func (v *GenesisValidator) HeimdallValidator() hmTypes.Validator {
 	}
 }
 
+// StakingQueue staking records of root chain waiting for sync, in queue order
+type StakingQueue struct {
+	RootChain string          `json:"root_chain" yaml:"root_chain"`
+	Records   []StakingRecord `json:"records" yaml:"records"`
+}
+
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
 	Params           Params               `json:"params" yaml:"params"`
 	Validators       []*hmTypes.Validator `json:"validators" yaml:"validators"`
 	CurrentValSet    hmTypes.ValidatorSet `json:"current_val_set" yaml:"current_val_set"`
 	StakingSequences []string             `json:"staking_sequences" yaml:"staking_sequences"`
+	StakingQueues    []StakingQueue       `json:"staking_queues" yaml:"staking_queues"`
+
+	ValidatorSetSnapshots []ValidatorSetSnapshot `json:"validator_set_snapshots" yaml:"validator_set_snapshots"`
+	CheckpointProposals   []CheckpointProposal   `json:"checkpoint_proposals" yaml:"checkpoint_proposals"`
+	ValidatorMetadata     []ValidatorMetadata    `json:"validator_metadata" yaml:"validator_metadata"`
+	Delegations           []Delegation           `json:"delegations" yaml:"delegations"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -46,18 +59,29 @@ func NewGenesisState(
 	validators []*hmTypes.Validator,
 	currentValSet hmTypes.ValidatorSet,
 	stakingSequences []string,
+	stakingQueues []StakingQueue,
+	validatorSetSnapshots []ValidatorSetSnapshot,
+	checkpointProposals []CheckpointProposal,
+	validatorMetadata []ValidatorMetadata,
+	delegations []Delegation,
 ) GenesisState {
 	return GenesisState{
 		Params:           params,
 		Validators:       validators,
 		CurrentValSet:    currentValSet,
 		StakingSequences: stakingSequences,
+		StakingQueues:    stakingQueues,
+
+		ValidatorSetSnapshots: validatorSetSnapshots,
+		CheckpointProposals:   checkpointProposals,
+		ValidatorMetadata:     validatorMetadata,
+		Delegations:           delegations,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), nil, hmTypes.ValidatorSet{}, nil)
+	return NewGenesisState(DefaultParams(), nil, hmTypes.ValidatorSet{}, nil, nil, nil, nil, nil, nil)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
@@ -73,6 +97,48 @@ func ValidateGenesis(data GenesisState) error {
 			return errors.New("Invalid Sequence")
 		}
 	}
+	for i, snapshot := range data.ValidatorSetSnapshots {
+		if snapshot.Height < 0 || (i > 0 && snapshot.Height <= data.ValidatorSetSnapshots[i-1].Height) {
+			return fmt.Errorf("Invalid validator set snapshot height %v", snapshot.Height)
+		}
+	}
+	for _, proposal := range data.CheckpointProposals {
+		if proposal.RootChain == "" || proposal.Number == 0 || proposal.Height < 0 {
+			return fmt.Errorf("Invalid checkpoint proposal %v of %v", proposal.Number, proposal.RootChain)
+		}
+	}
+	for _, metadata := range data.ValidatorMetadata {
+		if metadata.ID == 0 {
+			return errors.New("Invalid validator metadata id")
+		}
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+	}
+	delegations := make(map[string]bool, len(data.Delegations))
+	for _, delegation := range data.Delegations {
+		if err := delegation.Validate(); err != nil {
+			return err
+		}
+		delegationID := fmt.Sprintf("%v-%v", delegation.ValidatorID, delegation.DelegatorAddress)
+		if delegations[delegationID] {
+			return fmt.Errorf("Duplicate delegation %v", delegation.String())
+		}
+		delegations[delegationID] = true
+	}
+	for _, queue := range data.StakingQueues {
+		if queue.RootChain == "" {
+			return errors.New("Invalid staking queue root chain")
+		}
+		records := make(map[string]bool, len(queue.Records))
+		for _, record := range queue.Records {
+			recordID := fmt.Sprintf("%v-%v", record.ValidatorID, record.Nonce)
+			if records[recordID] {
+				return fmt.Errorf("Duplicate staking queue record %v", record.String())
+			}
+			records[recordID] = true
+		}
+	}
 
 	return nil
 }
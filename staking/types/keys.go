@@ -18,4 +18,10 @@ const (
 
 	// DefaultValPower default validator power
 	DefaultValPower = 10
+
+	// MaxValidatorSetSnapshots number of latest validator set snapshots kept in store
+	MaxValidatorSetSnapshots = 1000
+
+	// MaxCheckpointProposals number of latest checkpoint proposal heights kept per root chain
+	MaxCheckpointProposals = 1000
 )
@@ -512,3 +512,229 @@ func (msg MsgStakingSyncAck) ValidateBasic() sdk.Error {
 func (msg MsgStakingSyncAck) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// validator metadata
+//
+var _ sdk.Msg = &MsgEditValidatorMetadata{}
+
+// MsgEditValidatorMetadata sets human readable description of validator, signed by validator signer
+type MsgEditValidatorMetadata struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ValidatorID hmTypes.ValidatorID     `json:"id"`
+	Moniker     string                  `json:"moniker"`
+	Website     string                  `json:"website"`
+	Contact     string                  `json:"contact"`
+	Identity    string                  `json:"identity"`
+}
+
+func NewMsgEditValidatorMetadata(from hmTypes.HeimdallAddress, id hmTypes.ValidatorID, moniker, website, contact, identity string) MsgEditValidatorMetadata {
+	return MsgEditValidatorMetadata{
+		From:        from,
+		ValidatorID: id,
+		Moniker:     moniker,
+		Website:     website,
+		Contact:     contact,
+		Identity:    identity,
+	}
+}
+
+func (msg MsgEditValidatorMetadata) Type() string {
+	return "edit-validator-metadata"
+}
+
+func (msg MsgEditValidatorMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgEditValidatorMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgEditValidatorMetadata) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgEditValidatorMetadata) ValidateBasic() sdk.Error {
+	if msg.ValidatorID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ValidatorID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid signer %v", msg.From.String())
+	}
+
+	if err := msg.GetMetadata().Validate(); err != nil {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid metadata %v", err)
+	}
+
+	return nil
+}
+
+// GetMetadata returns validator metadata carried by msg
+func (msg MsgEditValidatorMetadata) GetMetadata() ValidatorMetadata {
+	return NewValidatorMetadata(msg.ValidatorID, msg.Moniker, msg.Website, msg.Contact, msg.Identity)
+}
+
+//
+// delegation
+//
+var _ sdk.Msg = &MsgDelegate{}
+
+// MsgDelegate delegator shares minted on validator, mirrors ShareMinted event of stake chain
+type MsgDelegate struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Shares      sdk.Int                 `json:"shares"`
+	Amount      sdk.Int                 `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+func NewMsgDelegate(from hmTypes.HeimdallAddress, id uint64, delegator hmTypes.HeimdallAddress, shares sdk.Int, amount sdk.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgDelegate {
+	return MsgDelegate{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Shares:      shares,
+		Amount:      amount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgDelegate) Type() string {
+	return "delegate"
+}
+
+func (msg MsgDelegate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgDelegate) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgDelegate) ValidateBasic() sdk.Error {
+	return validateDelegationMsg(msg.From, msg.ID, msg.Delegator, msg.Shares, msg.Amount)
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgDelegate) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgDelegate) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgDelegate) GetSideSignBytes() []byte {
+	return nil
+}
+
+var _ sdk.Msg = &MsgUndelegate{}
+
+// MsgUndelegate delegator shares burned on validator, mirrors ShareBurned event of stake chain
+type MsgUndelegate struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Shares      sdk.Int                 `json:"shares"`
+	Amount      sdk.Int                 `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+func NewMsgUndelegate(from hmTypes.HeimdallAddress, id uint64, delegator hmTypes.HeimdallAddress, shares sdk.Int, amount sdk.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgUndelegate {
+	return MsgUndelegate{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Shares:      shares,
+		Amount:      amount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgUndelegate) Type() string {
+	return "undelegate"
+}
+
+func (msg MsgUndelegate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUndelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgUndelegate) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgUndelegate) ValidateBasic() sdk.Error {
+	return validateDelegationMsg(msg.From, msg.ID, msg.Delegator, msg.Shares, msg.Amount)
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgUndelegate) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgUndelegate) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgUndelegate) GetSideSignBytes() []byte {
+	return nil
+}
+
+func validateDelegationMsg(from hmTypes.HeimdallAddress, id hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, shares sdk.Int, amount sdk.Int) sdk.Error {
+	if id == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", id)
+	}
+
+	if from.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", from.String())
+	}
+
+	if delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", delegator.String())
+	}
+
+	if shares == (sdk.Int{}) || !shares.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid shares %v", shares)
+	}
+
+	if amount == (sdk.Int{}) || amount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", amount)
+	}
+
+	return nil
+}
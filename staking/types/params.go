@@ -17,14 +17,17 @@ const (
 
 // Parameter keys
 var (
-	KeyStakingBufferTime = []byte("StakingBufferTime")
+	KeyStakingBufferTime        = []byte("StakingBufferTime")
+	KeyMaxValidatorPowerPercent = []byte("MaxValidatorPowerPercent")
 )
 
-var _ subspace.ParamSet = &Params{}
+var _ subspace.OptionalParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
 	StakingBufferTime time.Duration `json:"staking_buffer_time" yaml:"staking_buffer_time"`
+	// cap of single validator effective power in percent of total, disabled if zero
+	MaxValidatorPowerPercent uint64 `json:"max_validator_power_percent,omitempty" yaml:"max_validator_power_percent,omitempty"`
 }
 
 // NewParams creates a new Params object
@@ -36,7 +39,8 @@ func NewParams(stakingBufferTime time.Duration) Params {
 
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().
+		RegisterParamSet(&Params{})
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -48,6 +52,13 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	}
 }
 
+// OptionalParamSetPairs implements the OptionalParamSet interface and returns params added after chain start.
+func (p *Params) OptionalParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyMaxValidatorPowerPercent, Value: &p.MaxValidatorPowerPercent},
+	}
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
@@ -65,6 +76,7 @@ func (p Params) String() string {
 	var sb strings.Builder
 	sb.WriteString("Params: \n")
 	sb.WriteString(fmt.Sprintf("CheckpointBufferTime: %s\n", p.StakingBufferTime))
+	sb.WriteString(fmt.Sprintf("MaxValidatorPowerPercent: %d\n", p.MaxValidatorPowerPercent))
 	return sb.String()
 }
 
@@ -73,5 +85,8 @@ func (p Params) Validate() error {
 	if p.StakingBufferTime == 0 {
 		return fmt.Errorf("StakingBufferTime, AvgCheckpointLength should be non-zero")
 	}
+	if p.MaxValidatorPowerPercent > 100 {
+		return fmt.Errorf("MaxValidatorPowerPercent should not be more than 100, got %d", p.MaxValidatorPowerPercent)
+	}
 	return nil
 }
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"sort"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CapValidatorPowers returns effective powers where no single power exceeds capPercent of effective total.
+// Powers above the cap are lowered to it, others are kept. Cap is not applied when it is disabled (zero),
+// not limiting (100) or can't be met because there are too few validators.
+func CapValidatorPowers(powers []int64, capPercent uint64) []int64 {
+	effective := make([]int64, len(powers))
+	copy(effective, powers)
+
+	if capPercent == 0 || capPercent >= 100 || uint64(len(powers))*capPercent <= 100 {
+		return effective
+	}
+
+	// order by power descending, ties by position to stay deterministic
+	order := make([]int, len(powers))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return powers[order[a]] > powers[order[b]]
+	})
+
+	var rest int64
+	for _, power := range powers {
+		rest += power
+	}
+
+	// with k largest powers capped, cap is capPercent of total: cap = capPercent * rest / (100 - k * capPercent)
+	for k := 0; uint64(k)*capPercent < 100; k++ {
+		capPower := int64(capPercent) * rest / int64(100-uint64(k)*capPercent)
+		if powers[order[k]] <= capPower {
+			for _, i := range order[:k] {
+				effective[i] = capPower
+			}
+
+			return effective
+		}
+
+		rest -= powers[order[k]]
+	}
+
+	return effective
+}
+
+// ValidatorPower raw and effective power of validator
+type ValidatorPower struct {
+	ID             hmTypes.ValidatorID     `json:"ID"`
+	Signer         hmTypes.HeimdallAddress `json:"signer"`
+	Power          int64                   `json:"power"`
+	EffectivePower int64                   `json:"effective_power"`
+}
+
+// ValidatorPowers raw and effective powers of current validator set
+type ValidatorPowers struct {
+	MaxValidatorPowerPercent uint64           `json:"max_validator_power_percent"`
+	TotalPower               int64            `json:"total_power"`
+	TotalEffectivePower      int64            `json:"total_effective_power"`
+	Validators               []ValidatorPower `json:"validators"`
+}
+
+// NewValidatorPowers applies power cap to validators and sums up raw and effective powers
+func NewValidatorPowers(validators []*hmTypes.Validator, capPercent uint64) ValidatorPowers {
+	powers := make([]int64, len(validators))
+	for i, validator := range validators {
+		powers[i] = validator.VotingPower
+	}
+
+	effective := CapValidatorPowers(powers, capPercent)
+
+	result := ValidatorPowers{
+		MaxValidatorPowerPercent: capPercent,
+		Validators:               make([]ValidatorPower, 0, len(validators)),
+	}
+
+	for i, validator := range validators {
+		result.TotalPower += powers[i]
+		result.TotalEffectivePower += effective[i]
+		result.Validators = append(result.Validators, ValidatorPower{
+			ID:             validator.ID,
+			Signer:         validator.Signer,
+			Power:          powers[i],
+			EffectivePower: effective[i],
+		})
+	}
+
+	return result
+}
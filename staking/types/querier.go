@@ -17,6 +17,15 @@ const (
 	QueryStakingSequence      = "staking-sequence"
 	QueryNextStaking          = "staking-next"
 	QueryStakingQueue         = "staking-queue"
+
+	QueryValidatorSetAtHeight     = "validator-set-at-height"
+	QueryValidatorSetAtCheckpoint = "validator-set-at-checkpoint"
+
+	QueryDelegation           = "delegation"
+	QueryDelegatorDelegations = "delegator-delegations"
+	QueryValidatorDelegations = "validator-delegations"
+
+	QueryValidatorPowers = "validator-powers"
 )
 
 // QuerySignerParams defines the params for querying by address
@@ -88,3 +97,24 @@ func NewQueryStakingParams(number uint64, rootChain string) QueryStakingParams {
 		RootChain: rootChain,
 	}
 }
+
+// QueryValidatorSetAtHeightParams defines the params for querying validator set snapshot by height.
+type QueryValidatorSetAtHeightParams struct {
+	Height int64 `json:"height"`
+}
+
+// NewQueryValidatorSetAtHeightParams creates a new instance of QueryValidatorSetAtHeightParams.
+func NewQueryValidatorSetAtHeightParams(height int64) QueryValidatorSetAtHeightParams {
+	return QueryValidatorSetAtHeightParams{Height: height}
+}
+
+// QueryDelegationParams defines the params for querying delegations of delegator and/or validator.
+type QueryDelegationParams struct {
+	DelegatorAddress types.HeimdallAddress `json:"delegator_address"`
+	ValidatorID      types.ValidatorID     `json:"validator_id"`
+}
+
+// NewQueryDelegationParams creates a new instance of QueryDelegationParams.
+func NewQueryDelegationParams(delegator types.HeimdallAddress, validatorID types.ValidatorID) QueryDelegationParams {
+	return QueryDelegationParams{DelegatorAddress: delegator, ValidatorID: validatorID}
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// validator metadata length limits
+const (
+	MaxMonikerLength  = 70
+	MaxWebsiteLength  = 140
+	MaxContactLength  = 140
+	MaxIdentityLength = 64
+)
+
+// ValidatorMetadata human readable description of validator
+type ValidatorMetadata struct {
+	ID       hmTypes.ValidatorID `json:"id"`
+	Moniker  string              `json:"moniker"`
+	Website  string              `json:"website"`
+	Contact  string              `json:"contact"`
+	Identity string              `json:"identity"`
+}
+
+// NewValidatorMetadata creates new validator metadata
+func NewValidatorMetadata(id hmTypes.ValidatorID, moniker, website, contact, identity string) ValidatorMetadata {
+	return ValidatorMetadata{
+		ID:       id,
+		Moniker:  moniker,
+		Website:  website,
+		Contact:  contact,
+		Identity: identity,
+	}
+}
+
+// Empty checks if metadata has no description
+func (m ValidatorMetadata) Empty() bool {
+	return m.Moniker == "" && m.Website == "" && m.Contact == "" && m.Identity == ""
+}
+
+// Validate checks metadata length limits
+func (m ValidatorMetadata) Validate() error {
+	if len(m.Moniker) > MaxMonikerLength {
+		return fmt.Errorf("invalid moniker length; got: %d, max: %d", len(m.Moniker), MaxMonikerLength)
+	}
+
+	if len(m.Website) > MaxWebsiteLength {
+		return fmt.Errorf("invalid website length; got: %d, max: %d", len(m.Website), MaxWebsiteLength)
+	}
+
+	if len(m.Contact) > MaxContactLength {
+		return fmt.Errorf("invalid contact length; got: %d, max: %d", len(m.Contact), MaxContactLength)
+	}
+
+	if len(m.Identity) > MaxIdentityLength {
+		return fmt.Errorf("invalid identity length; got: %d, max: %d", len(m.Identity), MaxIdentityLength)
+	}
+
+	return nil
+}
+
+// String returns human readable string
+func (m ValidatorMetadata) String() string {
+	return fmt.Sprintf(
+		"ValidatorMetadata {%v %v %v %v %v}",
+		m.ID,
+		m.Moniker,
+		m.Website,
+		m.Contact,
+		m.Identity,
+	)
+}
+
+// ValidatorWithMetadata validator along with its metadata, returned by validator queries
+type ValidatorWithMetadata struct {
+	hmTypes.Validator
+
+	Metadata *ValidatorMetadata `json:"metadata,omitempty"`
+}
+
+// ValidatorSetWithMetadata validator set along with metadata of its validators
+type ValidatorSetWithMetadata struct {
+	hmTypes.ValidatorSet
+
+	Metadata map[hmTypes.ValidatorID]ValidatorMetadata `json:"metadata,omitempty"`
+}
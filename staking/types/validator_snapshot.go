@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SnapshotValidator compact validator entry of validator set snapshot
+type SnapshotValidator struct {
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Signer      hmTypes.HeimdallAddress `json:"signer"`
+	VotingPower int64                   `json:"power"`
+}
+
+// ValidatorSetSnapshot validator set as it was from height on
+type ValidatorSetSnapshot struct {
+	Height     int64               `json:"height"`
+	Validators []SnapshotValidator `json:"validators"`
+}
+
+// NewValidatorSetSnapshot creates snapshot of validator set taken at height
+func NewValidatorSetSnapshot(height int64, validatorSet hmTypes.ValidatorSet) ValidatorSetSnapshot {
+	validators := make([]SnapshotValidator, 0, len(validatorSet.Validators))
+	for _, validator := range validatorSet.Validators {
+		validators = append(validators, SnapshotValidator{
+			ID:          validator.ID,
+			Signer:      validator.Signer,
+			VotingPower: validator.VotingPower,
+		})
+	}
+
+	return ValidatorSetSnapshot{
+		Height:     height,
+		Validators: validators,
+	}
+}
+
+// SameValidators checks if both snapshots hold same validators with same signers and power
+func (s ValidatorSetSnapshot) SameValidators(other ValidatorSetSnapshot) bool {
+	if len(s.Validators) != len(other.Validators) {
+		return false
+	}
+
+	for i, validator := range s.Validators {
+		if validator != other.Validators[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TotalVotingPower returns total power of snapshot validators
+func (s ValidatorSetSnapshot) TotalVotingPower() (total int64) {
+	for _, validator := range s.Validators {
+		total += validator.VotingPower
+	}
+
+	return total
+}
+
+// String returns human readable string
+func (s ValidatorSetSnapshot) String() string {
+	return fmt.Sprintf(
+		"ValidatorSetSnapshot {%v %v}",
+		s.Height,
+		s.Validators,
+	)
+}
+
+// CheckpointProposal height at which checkpoint number of root chain was proposed into buffer
+type CheckpointProposal struct {
+	RootChain string `json:"root_chain" yaml:"root_chain"`
+	Number    uint64 `json:"number" yaml:"number"`
+	Height    int64  `json:"height" yaml:"height"`
+}
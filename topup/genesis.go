@@ -18,6 +18,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 
+	// accounts are initialized before topup
+	keeper.InitFeeSupply(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
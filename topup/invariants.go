@@ -0,0 +1,62 @@
+package topup
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers all topup invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "dividend-accounts", DividendAccountsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the topup module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return DividendAccountsInvariant(keeper)(ctx)
+	}
+}
+
+// DividendAccountsInvariant checks that every dividend account holds a valid fee amount
+// and that fee supply less fee still held by accounts, i.e. fee withdrawn, matches their total
+func DividendAccountsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		total := big.NewInt(0)
+
+		keeper.IterateDividendAccountsByPrefixAndApplyFn(ctx, DividendAccountMapKey, func(dividendAccount hmTypes.DividendAccount) error {
+			fee, ok := big.NewInt(0).SetString(dividendAccount.FeeAmount, 10)
+			if !ok || fee.Sign() < 0 {
+				broken = true
+				msg += fmt.Sprintf("\tdividend account %s has invalid fee amount %s\n", dividendAccount.User, dividendAccount.FeeAmount)
+
+				return nil
+			}
+
+			total.Add(total, fee)
+
+			return nil
+		})
+
+		// fee supply is tracked from genesis or upgrade, fee moves only by topups and withdrawals
+		if supply, ok := keeper.GetFeeSupply(ctx); ok {
+			accountsTotal := keeper.GetAccountsFeeTotal(ctx)
+			withdrawn := big.NewInt(0).Sub(supply, accountsTotal)
+			if withdrawn.Cmp(total) != 0 {
+				broken = true
+				msg += fmt.Sprintf("\tfee supply: %s\n\tfee held by accounts: %s\n\tsum of dividend accounts: %s\n", supply, accountsTotal, total)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "dividend-accounts", msg), broken
+	}
+}
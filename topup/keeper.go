@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/maticnetwork/heimdall/auth"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/bank"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/params/subspace"
@@ -24,6 +26,8 @@ var (
 	TopupSequencePrefixKey = []byte{0x81}
 
 	DividendAccountMapKey = []byte{0x82} // prefix for each key for Dividend Account Map
+
+	FeeSupplyKey = []byte{0x83} // key to store fee supply, fee held by accounts and dividend accounts at genesis or upgrade plus topups since
 )
 
 // Keeper stores all related data
@@ -38,6 +42,8 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// chain keeper
 	chainKeeper chainmanager.Keeper
+	// account keeper
+	ak auth.AccountKeeper
 	// bank keeper
 	bk bank.Keeper
 	// staking keeper
@@ -51,6 +57,7 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
+	accountKeeper auth.AccountKeeper,
 	bankKeeper bank.Keeper,
 	stakingKeeper staking.Keeper,
 ) Keeper {
@@ -60,6 +67,7 @@ func NewKeeper(
 		paramSpace:  paramSpace,
 		codespace:   codespace,
 		chainKeeper: chainKeeper,
+		ak:          accountKeeper,
 		bk:          bankKeeper,
 		sk:          stakingKeeper,
 	}
@@ -209,6 +217,71 @@ func (k *Keeper) AddFeeToDividendAccount(ctx sdk.Context, userAddress hmTypes.He
 	return nil
 }
 
+// InitFeeSupply sets fee supply to fee held by accounts and dividend accounts
+func (k *Keeper) InitFeeSupply(ctx sdk.Context) {
+	supply := k.GetAccountsFeeTotal(ctx)
+	k.SetFeeSupply(ctx, supply.Add(supply, k.GetDividendAccountsTotal(ctx)))
+}
+
+// AddTopupToFeeSupply adds topup fee to fee supply if it is tracked
+func (k *Keeper) AddTopupToFeeSupply(ctx sdk.Context, fee *big.Int) {
+	supply, ok := k.GetFeeSupply(ctx)
+	if !ok {
+		return
+	}
+
+	k.SetFeeSupply(ctx, supply.Add(supply, fee))
+}
+
+// SetFeeSupply sets fee supply
+func (k *Keeper) SetFeeSupply(ctx sdk.Context, supply *big.Int) {
+	store := ctx.KVStore(k.key)
+	store.Set(FeeSupplyKey, []byte(supply.String()))
+}
+
+// GetFeeSupply returns fee supply and whether it is tracked, it is tracked from genesis
+// or from TopupFeeSupply upgrade for chains started before
+func (k *Keeper) GetFeeSupply(ctx sdk.Context) (*big.Int, bool) {
+	store := ctx.KVStore(k.key)
+	if !store.Has(FeeSupplyKey) {
+		return big.NewInt(0), false
+	}
+
+	supply, ok := big.NewInt(0).SetString(string(store.Get(FeeSupplyKey)), 10)
+	if !ok {
+		k.Logger(ctx).Error("Unable to parse fee supply")
+		return big.NewInt(0), false
+	}
+
+	return supply, true
+}
+
+// GetAccountsFeeTotal returns sum of fee token held by all accounts, module accounts included
+func (k *Keeper) GetAccountsFeeTotal(ctx sdk.Context) *big.Int {
+	total := big.NewInt(0)
+
+	k.ak.IterateAccounts(ctx, func(account authTypes.Account) bool {
+		total.Add(total, account.GetCoins().AmountOf(authTypes.FeeToken).BigInt())
+		return false
+	})
+
+	return total
+}
+
+// GetDividendAccountsTotal returns sum of fee amounts of all dividend accounts
+func (k *Keeper) GetDividendAccountsTotal(ctx sdk.Context) *big.Int {
+	total := big.NewInt(0)
+
+	k.IterateDividendAccountsByPrefixAndApplyFn(ctx, DividendAccountMapKey, func(dividendAccount hmTypes.DividendAccount) error {
+		if fee, ok := big.NewInt(0).SetString(dividendAccount.FeeAmount, 10); ok {
+			total.Add(total, fee)
+		}
+		return nil
+	})
+
+	return total
+}
+
 // IterateDividendAccountsByPrefixAndApplyFn iterate dividendAccounts and apply the given function.
 func (k *Keeper) IterateDividendAccountsByPrefixAndApplyFn(ctx sdk.Context, prefix []byte, f func(dividendAccount hmTypes.DividendAccount) error) {
 	store := ctx.KVStore(k.key)
@@ -9,7 +9,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
@@ -94,3 +97,44 @@ func (suite *KeeperTestSuite) TestDividendAccountTree() {
 	require.NotNil(t, leafHash)
 	require.NoError(t, err)
 }
+
+func (suite *KeeperTestSuite) TestDividendAccountsInvariant() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	address := hmTypes.HexToHeimdallAddress("234452")
+
+	// fee supply is tracked from genesis
+	_, ok := app.TopupKeeper.GetFeeSupply(ctx)
+	require.True(t, ok)
+
+	// topup and partial withdrawal
+	coins := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: sdk.NewInt(150)}}
+	_, sdkErr := app.BankKeeper.AddCoins(ctx, address, coins)
+	require.Nil(t, sdkErr)
+	app.TopupKeeper.AddTopupToFeeSupply(ctx, big.NewInt(150))
+
+	result := topup.HandleMsgWithdrawFee(ctx, app.TopupKeeper, topupTypes.NewMsgWithdrawFee(address, sdk.NewInt(100)))
+	require.True(t, result.IsOK())
+
+	dividendAccount, err := app.TopupKeeper.GetDividendAccountByAddress(ctx, address)
+	require.NoError(t, err)
+	require.Equal(t, "100", dividendAccount.FeeAmount)
+
+	_, broken := topup.DividendAccountsInvariant(app.TopupKeeper)(ctx)
+	require.False(t, broken)
+
+	// dividend account changed without a withdrawal
+	dividendAccount.FeeAmount = big.NewInt(200).String()
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, dividendAccount))
+
+	_, broken = topup.DividendAccountsInvariant(app.TopupKeeper)(ctx)
+	require.True(t, broken)
+
+	// fee added to account without a topup
+	dividendAccount.FeeAmount = big.NewInt(100).String()
+	require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, dividendAccount))
+	_, sdkErr = app.BankKeeper.AddCoins(ctx, address, coins)
+	require.Nil(t, sdkErr)
+
+	_, broken = topup.DividendAccountsInvariant(app.TopupKeeper)(ctx)
+	require.True(t, broken)
+}
@@ -128,6 +128,8 @@ func PostHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, sideTxRes
 		return err.Result()
 	}
 
+	k.AddTopupToFeeSupply(ctx, msg.Fee.BigInt())
+
 	// transfer fees to sender (proposer)
 	if err := k.bk.SendCoins(ctx, user, msg.FromAddress, auth.DefaultFeeWantedPerTx); err != nil {
 		return err.Result()
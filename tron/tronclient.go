@@ -89,6 +89,20 @@ func (tc *Client) GetNowBlock(ctx context.Context) (int64, error) {
 	return block.BlockHeader.RawData.Number, nil
 }
 
+// GetEnergyFee returns current energy price in sun
+func (tc *Client) GetEnergyFee(ctx context.Context) (int64, error) {
+	params, err := tc.client.GetChainParameters(ctx, &pb.EmptyMessage{})
+	if err != nil {
+		return 0, err
+	}
+	for _, param := range params.ChainParameter {
+		if param.Key == "getEnergyFee" {
+			return param.Value, nil
+		}
+	}
+	return 0, fmt.Errorf("energy fee not found in chain parameters")
+}
+
 // CurrentHeaderBlock is a free data retrieval call binding the contract method 0xec7e4855.
 //
 // Solidity: function currentHeaderBlock() view returns(uint256)
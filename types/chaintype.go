@@ -8,14 +8,16 @@ const (
 	RootChainTypeStake = RootChainTypeTron
 )
 
-var chainIDMap = map[string]byte{RootChainTypeTron: 1, RootChainTypeEth: 2, RootChainTypeBsc: 3}
+// DefaultRootChainIDs root chain ids known before chain manager registry,
+// ids of chains added later are only resolved through chain manager
+var DefaultRootChainIDs = map[string]byte{RootChainTypeTron: 1, RootChainTypeEth: 2, RootChainTypeBsc: 3}
 
 func GetRootChainID(rootChain string) byte {
-	return chainIDMap[rootChain]
+	return DefaultRootChainIDs[rootChain]
 }
 
 func GetRootChainName(rootChainID uint64) string {
-	for chainName, chainID := range chainIDMap {
+	for chainName, chainID := range DefaultRootChainIDs {
 		if uint64(chainID) == rootChainID {
 			return chainName
 		}
@@ -24,5 +26,9 @@ func GetRootChainName(rootChainID uint64) string {
 }
 
 func GetRootChainIDMap() map[string]byte {
-	return chainIDMap
+	res := make(map[string]byte, len(DefaultRootChainIDs))
+	for chainName, chainID := range DefaultRootChainIDs {
+		res[chainName] = chainID
+	}
+	return res
 }
@@ -20,6 +20,12 @@ type ValidatorSigningInfo struct {
 	// Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 	// missed blocks counter (to avoid scanning the array every time)
 	MissedBlocksCounter int64 `json:"missed_blocks_counter,omitempty"`
+	// last planned maintenance window signalled by validator, misses in it aren't downtime
+	MaintenanceStartHeight int64 `json:"maintenance_start_height,omitempty"`
+	MaintenanceEndHeight   int64 `json:"maintenance_end_height,omitempty"`
+	// maintenance windows signalled and blocks missed in them
+	MaintenanceWindows      int64 `json:"maintenance_windows,omitempty"`
+	MaintenanceMissedBlocks int64 `json:"maintenance_missed_blocks,omitempty"`
 }
 
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
@@ -44,9 +50,18 @@ func (i ValidatorSigningInfo) String() string {
   valID:               %d
   Start Height:          %d
   Index Offset:          %d  
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Maintenance Window:    %d - %d
+  Maintenance Windows:   %d
+  Maintenance Missed Blocks: %d`,
 		i.ValID, i.StartHeight, i.IndexOffset,
-		i.MissedBlocksCounter)
+		i.MissedBlocksCounter, i.MaintenanceStartHeight, i.MaintenanceEndHeight,
+		i.MaintenanceWindows, i.MaintenanceMissedBlocks)
+}
+
+// InMaintenance returns true if height is in signalled maintenance window
+func (i ValidatorSigningInfo) InMaintenance(height int64) bool {
+	return i.MaintenanceWindows > 0 && height >= i.MaintenanceStartHeight && height <= i.MaintenanceEndHeight
 }
 
 // amino marshall validator